@@ -286,6 +286,37 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 				},
 			},
 
+			{
+				Name:      "import-key",
+				Usage:     "Import a minipool's validator key from an encrypted EIP-2335 keystore file, for validators that weren't created by this node's wallet",
+				UsageText: "rocketpool minipool import-key [options] minipool-address keystore-file",
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "password, p",
+						Usage: "The password the keystore was encrypted with",
+					},
+					cli.BoolFlag{
+						Name:  "yes, y",
+						Usage: "Automatically confirm that the validator key is no longer running anywhere else",
+					},
+				},
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 2); err != nil {
+						return err
+					}
+					minipoolAddress, err := cliutils.ValidateAddress("minipool address", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					return importKey(c, minipoolAddress, c.Args().Get(1))
+
+				},
+			},
+
 			{
 				Name:      "find-vanity-address",
 				Aliases:   []string{"v"},
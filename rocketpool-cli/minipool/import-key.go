@@ -0,0 +1,65 @@
+package minipool
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
+)
+
+func importKey(c *cli.Context, minipoolAddress common.Address, keystorePath string) error {
+
+	// Get RP client
+	rp, err := rocketpool.NewClientFromCtx(c)
+	if err != nil {
+		return err
+	}
+	defer rp.Close()
+
+	// Check and assign the EC status
+	err = cliutils.CheckClientStatus(rp)
+	if err != nil {
+		return err
+	}
+
+	// Read the keystore
+	keystore, err := ioutil.ReadFile(keystorePath)
+	if err != nil {
+		return fmt.Errorf("Could not read keystore from %s: %w", keystorePath, err)
+	}
+
+	// Get the keystore password
+	password := c.String("password")
+	if password == "" {
+		password = cliutils.PromptPassword("Please enter the password for the keystore:", "^.*$", "")
+	}
+
+	// Warn about double signing
+	colorReset := "\033[0m"
+	colorRed := "\033[31m"
+	fmt.Printf("%s***WARNING***\n", colorRed)
+	fmt.Println("If this validator key is still loaded in another Validator Client (on another machine or in another staking service), running it here as well will make it sign twice and it WILL BE SLASHED.")
+	fmt.Printf("Make sure the key has been removed from everywhere else and that at least two epochs have passed since it last attested before you restart your Validator Client.\n\n%s", colorReset)
+
+	// Prompt for confirmation
+	if !(c.Bool("yes") || cliutils.Confirm("Have you confirmed this validator key is no longer running anywhere else?")) {
+		fmt.Println("Cancelled.")
+		return nil
+	}
+
+	// Import the key
+	response, err := rp.ImportMinipoolKey(minipoolAddress, keystore, password)
+	if err != nil {
+		return err
+	}
+
+	// Log & return
+	fmt.Printf("Successfully imported the validator key for minipool %s (%s).\n", minipoolAddress.Hex(), response.Pubkey.Hex())
+	fmt.Println("Please restart your Validator Client so it loads the new key.")
+	return nil
+
+}
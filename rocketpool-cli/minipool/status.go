@@ -13,6 +13,7 @@ import (
 	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
 	"github.com/rocket-pool/smartnode/shared/utils/hex"
 	"github.com/rocket-pool/smartnode/shared/utils/math"
+	rputils "github.com/rocket-pool/smartnode/shared/utils/rp"
 )
 
 const colorReset string = "\033[0m"
@@ -45,6 +46,7 @@ func getStatus(c *cli.Context) error {
 	refundableMinipools := []api.MinipoolDetails{}
 	withdrawableMinipools := []api.MinipoolDetails{}
 	closeableMinipools := []api.MinipoolDetails{}
+	penalizedMinipools := []api.MinipoolDetails{}
 	finalisedMinipools := []api.MinipoolDetails{}
 	for _, minipool := range status.Minipools {
 
@@ -66,12 +68,26 @@ func getStatus(c *cli.Context) error {
 			if minipool.CloseAvailable {
 				closeableMinipools = append(closeableMinipools, minipool)
 			}
+			if minipool.Penalties > 0 {
+				penalizedMinipools = append(penalizedMinipools, minipool)
+			}
 		} else {
 			finalisedMinipools = append(finalisedMinipools, minipool)
 		}
 
 	}
 
+	// Warn about slashed validators before anything else
+	if status.HasSlashedValidators {
+		fmt.Printf("%s=== WARNING: one or more of your validators has been SLASHED ===%s\n", colorRed, colorReset)
+		for _, minipool := range status.Minipools {
+			if minipool.Validator.Slashed {
+				fmt.Printf("%s- Minipool %s (validator %s)%s\n", colorRed, minipool.Address.Hex(), hex.AddPrefix(minipool.ValidatorPubkey.Hex()), colorReset)
+			}
+		}
+		fmt.Printf("%sSlashed validators are forcibly exited and lose part of their balance. Please check your validator client logs immediately and make sure the keys aren't running anywhere else.%s\n\n", colorRed, colorReset)
+	}
+
 	// Print minipool details by status
 	if len(status.Minipools) == 0 {
 		fmt.Println("The node does not have any minipools yet.")
@@ -108,6 +124,19 @@ func getStatus(c *cli.Context) error {
 
 	fmt.Println("")
 
+	// Warn about penalized minipools
+	if len(penalizedMinipools) > 0 {
+		fmt.Printf("%sWARNING: %d minipool(s) have been penalized:%s\n", colorYellow, len(penalizedMinipools), colorReset)
+		for _, minipool := range penalizedMinipools {
+			if minipool.PenaltyThresholdMet {
+				fmt.Printf("%s- %s has %d infractions and is at or above the penalty threshold of %d; it is no longer eligible for Smoothing Pool rewards.%s\n", colorRed, minipool.Address.Hex(), minipool.Penalties, rputils.MinipoolPenaltyThreshold, colorReset)
+			} else {
+				fmt.Printf("- %s has %d strike(s); it will be penalized once it reaches %d.\n", minipool.Address.Hex(), minipool.Penalties, rputils.MinipoolPenaltyThreshold)
+			}
+		}
+		fmt.Println("")
+	}
+
 	// Print actionable minipool details
 	if len(refundableMinipools) > 0 {
 		fmt.Printf("%d minipool(s) have refunds available:\n", len(refundableMinipools))
@@ -136,9 +165,12 @@ func printMinipoolDetails(minipool api.MinipoolDetails, latestDelegate common.Ad
 
 	// Main details
 	fmt.Printf("Address:              %s\n", minipool.Address.Hex())
+	if minipool.Validator.Slashed {
+		fmt.Printf("%sSlashed:              YES%s\n", colorRed, colorReset)
+	}
 	if minipool.Penalties == 0 {
 		fmt.Println("Penalties:            0")
-	} else if minipool.Penalties < 3 {
+	} else if !minipool.PenaltyThresholdMet {
 		fmt.Printf("%sStrikes:              %d%s\n", colorYellow, minipool.Penalties, colorReset)
 	} else {
 		fmt.Printf("%sInfractions:          %d%s\n", colorRed, minipool.Penalties, colorReset)
@@ -152,6 +184,18 @@ func printMinipoolDetails(minipool api.MinipoolDetails, latestDelegate common.Ad
 		fmt.Printf("Queue position:       %d\n", minipool.Queue.Position)
 	}
 
+	// Scrub check details - prelaunch minipools
+	if minipool.Status.Status == types.Prelaunch {
+		if minipool.InScrubPeriod {
+			fmt.Printf("Scrub check:          in progress (%s remaining)\n", minipool.TimeUntilScrubEnd)
+		} else {
+			fmt.Printf("Scrub check:          complete\n")
+		}
+		if minipool.ScrubVotes > 0 {
+			fmt.Printf("%sScrub votes:          %d of the %d Oracle DAO votes needed to scrub this minipool have been cast%s\n", colorRed, minipool.ScrubVotes, minipool.ScrubThreshold, colorReset)
+		}
+	}
+
 	// RP ETH deposit details - prelaunch & staking minipools
 	if minipool.Status.Status == types.Prelaunch || minipool.Status.Status == types.Staking {
 		if minipool.User.DepositAssigned {
@@ -195,7 +239,7 @@ func printMinipoolDetails(minipool api.MinipoolDetails, latestDelegate common.Ad
 	fmt.Printf("Rollback delegate:    %s\n", cliutils.GetPrettyAddress(minipool.PreviousDelegate))
 	fmt.Printf("Effective delegate:   %s\n", cliutils.GetPrettyAddress(minipool.EffectiveDelegate))
 
-	if minipool.EffectiveDelegate != latestDelegate {
+	if minipool.NeedsUpgrade {
 		fmt.Printf("%s*Minipool can be upgraded to delegate %s!%s\n", colorYellow, latestDelegate.Hex(), colorReset)
 	}
 
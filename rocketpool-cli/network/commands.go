@@ -100,6 +100,10 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 						Name:  "index",
 						Usage: "The index of the rewards interval you want to generate the tree for",
 					},
+					cli.BoolFlag{
+						Name:  "dry-run, d",
+						Usage: "Generate the tree into a separate folder for comparison, without replacing your existing rewards files",
+					},
 					cli.BoolFlag{
 						Name:  "yes, y",
 						Usage: "Automatically confirm any questions about tree generation",
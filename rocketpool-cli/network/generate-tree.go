@@ -3,6 +3,7 @@ package network
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
 	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
@@ -31,11 +32,11 @@ func generateRewardsTree(c *cli.Context) error {
 	}
 
 	// Print archive node info
-	archiveEcUrl := cfg.Smartnode.ArchiveECUrl.Value.(string)
-	if archiveEcUrl == "" {
+	archiveEcUrls := cfg.Smartnode.GetArchiveECUrls()
+	if len(archiveEcUrls) == 0 {
 		fmt.Printf("%sNOTE: in order to generate a Merkle rewards tree for a past rewards interval, you will likely need to have access to an Execution client with archival state.\nBy default, your Smartnode's Execution client will not provide this.\n\nPlease specify the URL of an archive-capable EC in the Smartnode section of the `rocketpool service config` Terminal UI.\nIf you need one, Alchemy provides a free service which you can use: https://www.alchemy.com/ethereum%s\n\n", colorYellow, colorReset)
 	} else {
-		fmt.Printf("%sYou have an archive EC specified at [%s]. This will be used for tree generation.%s\n\n", colorGreen, archiveEcUrl, colorReset)
+		fmt.Printf("%sYou have an archive EC specified at [%s]. This will be used for tree generation.%s\n\n", colorGreen, strings.Join(archiveEcUrls, ", "), colorReset)
 	}
 
 	// Get the index
@@ -60,7 +61,8 @@ func generateRewardsTree(c *cli.Context) error {
 	}
 
 	// Confirm file overwrite
-	if canResponse.TreeFileExists {
+	dryRun := c.Bool("dry-run")
+	if canResponse.TreeFileExists && !dryRun {
 		if c.Bool("yes") {
 			fmt.Println("Overwriting existing rewards file.")
 		} else if !cliutils.Confirm("You already have a rewards file for this interval. Would you like to overwrite it?") {
@@ -70,10 +72,13 @@ func generateRewardsTree(c *cli.Context) error {
 	}
 
 	// Create the generation request
-	_, err = rp.GenerateRewardsTree(index)
+	_, err = rp.GenerateRewardsTree(index, dryRun)
 	if err != nil {
 		return err
 	}
+	if dryRun {
+		fmt.Printf("This is a dry run, so the files will be saved to a new `dry-run-%d-*` folder in your watchtower directory and your existing rewards files will not be touched.\n", index)
+	}
 
 	fmt.Printf("Your request to generate the rewards tree for interval %d has been applied, and your `watchtower` container will begin the process during its next duty check (typically 5 minutes).\nYou can follow its progress with %s`rocketpool service logs watchtower`%s.\n\n", index, colorGreen, colorReset)
 
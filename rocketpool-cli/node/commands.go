@@ -60,6 +60,10 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 						Name:  "timezone, t",
 						Usage: "The timezone location to register the node with (in the format 'Country/City')",
 					},
+					cli.StringFlag{
+						Name:  "withdrawal-address, w",
+						Usage: "An address to set as the node's pending withdrawal address once it's registered",
+					},
 				},
 				Action: func(c *cli.Context) error {
 
@@ -74,6 +78,11 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 							return err
 						}
 					}
+					if c.String("withdrawal-address") != "" {
+						if _, err := cliutils.ValidateAddress("withdrawal address", c.String("withdrawal-address")); err != nil {
+							return err
+						}
+					}
 
 					// Run
 					return registerNode(c)
@@ -375,7 +384,7 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 			{
 				Name:      "send",
 				Aliases:   []string{"n"},
-				Usage:     "Send ETH or tokens from the node account to an address",
+				Usage:     "Send ETH or tokens from the node account to an address. The token can be ETH, RPL, fsRPL, rETH, or the address of any ERC20 token. Use an amount of 'max' to send the node's full balance (for ETH, enough is kept back to pay for gas).",
 				UsageText: "rocketpool node send [options] amount token to",
 				Flags: []cli.Flag{
 					cli.BoolFlag{
@@ -389,9 +398,13 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 					if err := cliutils.ValidateArgCount(c, 3); err != nil {
 						return err
 					}
-					amount, err := cliutils.ValidatePositiveEthAmount("send amount", c.Args().Get(0))
-					if err != nil {
-						return err
+					var amount float64
+					if c.Args().Get(0) != "max" {
+						var err error
+						amount, err = cliutils.ValidatePositiveEthAmount("send amount", c.Args().Get(0))
+						if err != nil {
+							return err
+						}
 					}
 					token, err := cliutils.ValidateTokenType("token type", c.Args().Get(1))
 					if err != nil {
@@ -487,12 +500,16 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 				Name:      "distribute-fees",
 				Aliases:   []string{"b"},
 				Usage:     "Distribute the priority fee and MEV rewards from your fee distributor to your withdrawal address and the rETH contract (based on your node's average commission)",
-				UsageText: "rocketpool node distribute-fees",
+				UsageText: "rocketpool node distribute-fees [options]",
 				Flags: []cli.Flag{
 					cli.BoolFlag{
 						Name:  "yes, y",
 						Usage: "Automatically confirm distribution",
 					},
+					cli.StringFlag{
+						Name:  "reserve, r",
+						Usage: "The minimum amount of ETH to keep in the node wallet for gas; you'll be warned if distributing would take the wallet below it",
+					},
 				},
 				Action: func(c *cli.Context) error {
 
@@ -2,6 +2,7 @@ package node
 
 import (
 	"fmt"
+	"math/big"
 
 	"github.com/urfave/cli"
 
@@ -89,8 +90,18 @@ func distribute(c *cli.Context) error {
 		return nil
 	}
 
+	// Get the reserve to keep in the node wallet
+	var reserveWei *big.Int
+	if c.String("reserve") != "" {
+		reserve, err := cliutils.ValidatePositiveEthAmount("reserve", c.String("reserve"))
+		if err != nil {
+			return err
+		}
+		reserveWei = eth.EthToWei(reserve)
+	}
+
 	// Get the gas estimate
-	canDistributeResponse, err := rp.CanDistribute()
+	canDistributeResponse, err := rp.CanDistribute(reserveWei)
 	if err != nil {
 		return err
 	}
@@ -102,12 +113,16 @@ func distribute(c *cli.Context) error {
 	}
 
 	// Print info
-	nodeShare := (1 + canDistributeResponse.AverageNodeFee) * balance / 2
-	rEthShare := balance - nodeShare
 	fmt.Printf("Your node's average commission is %.2f%%.\n", canDistributeResponse.AverageNodeFee*100.0)
 	fmt.Printf("Your fee distributor's balance of %.6f ETH will be distributed as follows:\n", balance)
-	fmt.Printf("\tYour withdrawal address will receive %.6f ETH.\n", nodeShare)
-	fmt.Printf("\trETH pool stakers will receive %.6f ETH.\n\n", rEthShare)
+	fmt.Printf("\tYour withdrawal address will receive %.6f ETH.\n", eth.WeiToEth(canDistributeResponse.NodeShare))
+	fmt.Printf("\trETH pool stakers will receive %.6f ETH.\n\n", eth.WeiToEth(canDistributeResponse.UserShare))
+
+	// Warn if the distribution would take the node wallet below its reserve
+	if canDistributeResponse.BelowReserve {
+		fmt.Printf("%sWARNING: your node wallet has %.6f ETH, and distributing will cost up to %.6f ETH in gas. This would leave less than the %.6f ETH reserve you asked to keep for gas.%s\n\n",
+			colorYellow, eth.WeiToEth(canDistributeResponse.NodeBalance), eth.WeiToEth(canDistributeResponse.GasCost), eth.WeiToEth(reserveWei), colorReset)
+	}
 
 	// Assign max fees
 	err = gas.AssignMaxFeeAndLimit(canDistributeResponse.GasInfo, rp, c.Bool("yes"))
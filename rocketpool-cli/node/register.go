@@ -3,11 +3,14 @@ package node
 import (
 	"fmt"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/rocketpool-go/utils/eth"
 	"github.com/urfave/cli"
 
 	"github.com/rocket-pool/smartnode/shared/services/gas"
 	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
 	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
+	"github.com/rocket-pool/smartnode/shared/utils/math"
 )
 
 func registerNode(c *cli.Context) error {
@@ -46,6 +49,9 @@ func registerNode(c *cli.Context) error {
 		if canRegister.RegistrationDisabled {
 			fmt.Println("Node registrations are currently disabled.")
 		}
+		if canRegister.InsufficientBalance {
+			fmt.Printf("The node address %s only has %.6f ETH, which is not enough to pay for the registration transaction's gas. Registration has no other cost; please send some ETH to the node address and try again.\n", canRegister.NodeAddress.Hex(), math.RoundDown(eth.WeiToEth(canRegister.NodeBalance), 6))
+		}
 		return nil
 	}
 
@@ -55,6 +61,16 @@ func registerNode(c *cli.Context) error {
 		return err
 	}
 
+	// Get the withdrawal address to set after registering
+	var withdrawalAddress common.Address
+	setWithdrawalAddress := (c.String("withdrawal-address") != "")
+	if setWithdrawalAddress {
+		withdrawalAddress = common.HexToAddress(c.String("withdrawal-address"))
+		fmt.Printf("Once the node is registered, %s will be set as its pending withdrawal address in a second transaction.\n", withdrawalAddress.Hex())
+		fmt.Println("Rocket Pool will continue to use the node address for withdrawals until you confirm the new address via the Rocket Pool website.")
+		fmt.Println()
+	}
+
 	// Prompt for confirmation
 	if !(c.Bool("yes") || cliutils.Confirm("Are you sure you want to register this node?")) {
 		fmt.Println("Cancelled.")
@@ -75,6 +91,48 @@ func registerNode(c *cli.Context) error {
 
 	// Log & return
 	fmt.Println("The node was successfully registered with Rocket Pool.")
+	if !setWithdrawalAddress {
+		return nil
+	}
+	fmt.Println()
+
+	// Check if the withdrawal address can be set
+	canSetWithdrawalAddress, err := rp.CanSetNodeWithdrawalAddress(withdrawalAddress, false)
+	if err != nil {
+		return fmt.Errorf("The node was registered, but its withdrawal address could not be set: %w\nYou can set it with `rocketpool node set-withdrawal-address`.", err)
+	}
+
+	// Assign max fees
+	err = gas.AssignMaxFeeAndLimit(canSetWithdrawalAddress.GasInfo, rp, c.Bool("yes"))
+	if err != nil {
+		return err
+	}
+
+	// Set node's withdrawal address
+	setResponse, err := rp.SetNodeWithdrawalAddress(withdrawalAddress, false)
+	if err != nil {
+		return fmt.Errorf("The node was registered, but its withdrawal address could not be set: %w\nYou can set it with `rocketpool node set-withdrawal-address`.", err)
+	}
+
+	fmt.Printf("Setting withdrawal address...\n")
+	cliutils.PrintTransactionHash(rp, setResponse.TxHash)
+	if _, err = rp.WaitForTransaction(setResponse.TxHash); err != nil {
+		return err
+	}
+
+	// Log & return
+	stakeUrl := ""
+	config, _, err := rp.LoadConfig()
+	if err == nil {
+		stakeUrl = config.Smartnode.GetStakeUrl()
+	}
+	if stakeUrl != "" {
+		fmt.Printf("The node's withdrawal address update to %s is now pending.\n"+
+			"To confirm it, please visit the Rocket Pool website (%s).\n", withdrawalAddress.Hex(), stakeUrl)
+	} else {
+		fmt.Printf("The node's withdrawal address update to %s is now pending.\n"+
+			"To confirm it, please visit the Rocket Pool website.\n", withdrawalAddress.Hex())
+	}
 	return nil
 
 }
@@ -2,6 +2,7 @@ package node
 
 import (
 	"fmt"
+	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/rocket-pool/rocketpool-go/utils/eth"
@@ -28,21 +29,40 @@ func nodeSend(c *cli.Context, amount float64, token string, toAddress common.Add
 		return err
 	}
 
-	// Get amount in wei
-	amountWei := eth.EthToWei(amount)
+	// Get amount in wei; an amount of 0 sends the node's full balance
+	var amountWei *big.Int
+	if amount > 0 {
+		amountWei = eth.EthToWei(amount)
+	}
+
+	// ERC20 tokens may not use 18 decimals, so look up the token's decimals to scale the amount
+	if common.IsHexAddress(token) && amountWei != nil {
+		tokenInfo, err := rp.CanNodeSend(nil, token)
+		if err != nil {
+			return err
+		}
+		amountWei = tokenAmountToBaseUnits(amount, tokenInfo.TokenDecimals)
+	}
 
 	// Check tokens can be sent
 	canSend, err := rp.CanNodeSend(amountWei, token)
 	if err != nil {
 		return err
 	}
+	tokenName := token
+	if canSend.TokenSymbol != "" {
+		tokenName = canSend.TokenSymbol
+	}
 	if !canSend.CanSend {
 		fmt.Println("Cannot send tokens:")
 		if canSend.InsufficientBalance {
-			fmt.Printf("The node's %s balance is insufficient.\n", token)
+			fmt.Printf("The node's %s balance is insufficient.\n", tokenName)
 		}
 		return nil
 	}
+	if common.IsHexAddress(token) {
+		fmt.Printf("Token %s is %s (%s), which uses %d decimals.\n\n", common.HexToAddress(token).Hex(), canSend.TokenName, canSend.TokenSymbol, canSend.TokenDecimals)
+	}
 
 	// Assign max fees
 	err = gas.AssignMaxFeeAndLimit(canSend.GasInfo, rp, c.Bool("yes"))
@@ -51,7 +71,13 @@ func nodeSend(c *cli.Context, amount float64, token string, toAddress common.Add
 	}
 
 	// Prompt for confirmation
-	if !(c.Bool("yes") || cliutils.Confirm(fmt.Sprintf("Are you sure you want to send %.6f %s to %s? This action cannot be undone!", math.RoundDown(eth.WeiToEth(amountWei), 6), token, toAddress.Hex()))) {
+	var confirmation string
+	if amountWei == nil && token == "eth" {
+		confirmation = fmt.Sprintf("Are you sure you want to send your entire ETH balance (approximately %.6f ETH after reserving gas) to %s? This action cannot be undone!", math.RoundDown(eth.WeiToEth(canSend.Amount), 6), toAddress.Hex())
+	} else {
+		confirmation = fmt.Sprintf("Are you sure you want to send %.6f %s to %s? This action cannot be undone!", math.RoundDown(tokenBaseUnitsToAmount(canSend.Amount, canSend.TokenDecimals), 6), tokenName, toAddress.Hex())
+	}
+	if !(c.Bool("yes") || cliutils.Confirm(confirmation)) {
 		fmt.Println("Cancelled.")
 		return nil
 	}
@@ -62,14 +88,28 @@ func nodeSend(c *cli.Context, amount float64, token string, toAddress common.Add
 		return err
 	}
 
-	fmt.Printf("Sending %s to %s...\n", token, toAddress.Hex())
+	fmt.Printf("Sending %s to %s...\n", tokenName, toAddress.Hex())
 	cliutils.PrintTransactionHash(rp, response.TxHash)
 	if _, err = rp.WaitForTransaction(response.TxHash); err != nil {
 		return err
 	}
 
 	// Log & return
-	fmt.Printf("Successfully sent %.6f %s to %s.\n", math.RoundDown(eth.WeiToEth(amountWei), 6), token, toAddress.Hex())
+	fmt.Printf("Successfully sent %.6f %s to %s.\n", math.RoundDown(tokenBaseUnitsToAmount(response.Amount, canSend.TokenDecimals), 6), tokenName, toAddress.Hex())
 	return nil
 
 }
+
+// Convert a human-readable token amount into the token's base units
+func tokenAmountToBaseUnits(amount float64, decimals uint8) *big.Int {
+	scale := new(big.Float).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil))
+	baseUnits, _ := new(big.Float).Mul(big.NewFloat(amount), scale).Int(nil)
+	return baseUnits
+}
+
+// Convert an amount in a token's base units into a human-readable amount
+func tokenBaseUnitsToAmount(baseUnits *big.Int, decimals uint8) float64 {
+	scale := new(big.Float).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil))
+	amount, _ := new(big.Float).Quo(new(big.Float).SetInt(baseUnits), scale).Float64()
+	return amount
+}
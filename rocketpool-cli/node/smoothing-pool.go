@@ -109,7 +109,7 @@ func leaveSmoothingPool(c *cli.Context) error {
 	}
 
 	if status.TimeLeftUntilChangeable > 0 {
-		fmt.Printf("You have recently joined the Smoothing Pool. You must wait %s until you can leave it.\n", status.TimeLeftUntilChangeable)
+		fmt.Printf("You joined the Smoothing Pool during interval %d. You must wait %s (until %s) before you can leave it.\n", status.RegistrationChangedInterval, status.TimeLeftUntilChangeable, status.ChangeAvailableTime.Format(TimeFormat))
 		return nil
 	}
 
@@ -12,6 +12,9 @@ import (
 	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
 )
 
+// Config
+const TimeFormat = "2006-01-02, 15:04 -0700 MST"
+
 // FreeGeoIP config
 const FreeGeoIPURL = "https://freegeoip.app/json/"
 
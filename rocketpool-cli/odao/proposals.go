@@ -159,11 +159,23 @@ func getProposal(c *cli.Context, id uint64) error {
 		return nil
 	}
 
+	// Get the decoded payload
+	proposalDetails, err := rp.TNDAOProposal(id)
+	if err != nil {
+		return err
+	}
+
 	// Main details
 	fmt.Printf("Proposal ID:          %d\n", proposal.ID)
 	fmt.Printf("Message:              %s\n", proposal.Message)
 	fmt.Printf("Payload:              %s\n", proposal.PayloadStr)
 	fmt.Printf("Payload (bytes):      %s\n", hex.EncodeToString(proposal.Payload))
+	if proposalDetails.PayloadFunction != "" {
+		fmt.Printf("Executes:             %s\n", proposalDetails.PayloadFunction)
+		for _, argument := range proposalDetails.PayloadArguments {
+			fmt.Printf("    %s (%s): %s\n", argument.Name, argument.Type, argument.Value)
+		}
+	}
 	fmt.Printf("Proposed by:          %s (%s)\n", memberID, proposal.ProposerAddress.Hex())
 	fmt.Printf("Created at:           %s\n", cliutils.GetDateTimeString(proposal.CreatedTime))
 
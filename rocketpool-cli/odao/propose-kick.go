@@ -104,6 +104,9 @@ func proposeKick(c *cli.Context) error {
 		if canPropose.ProposalCooldownActive {
 			fmt.Println("The node must wait for the proposal cooldown period to pass before making another proposal.")
 		}
+		if canPropose.MemberDoesNotExist {
+			fmt.Printf("%s is not a member of the oracle DAO.\n", selectedMember.Address.Hex())
+		}
 		if canPropose.InsufficientRplBond {
 			fmt.Printf("The fine amount of %.6f RPL is greater than the member's bond of %.6f RPL.\n", math.RoundDown(eth.WeiToEth(fineAmountWei), 6), math.RoundDown(eth.WeiToEth(selectedMember.RPLBondAmount), 6))
 		}
@@ -98,6 +98,14 @@ ______           _        _    ______           _
 			Name:  "debug",
 			Usage: "Enable debug printing of API commands",
 		},
+		cli.StringFlag{
+			Name:  "idempotency-key",
+			Usage: "An optional key for commands that send transactions; rerunning the command with the same key shortly afterwards returns the transactions it already sent instead of sending new ones",
+		},
+		cli.BoolFlag{
+			Name:  "finalized",
+			Usage: "Show minipool status and rewards information from the latest finalized block instead of the chain head, so it can't be changed by a reorg. Other information is always read from the chain head",
+		},
 		cli.BoolFlag{
 			Name: "secure-session, s",
 			Usage: "Some commands may print sensitive information to your terminal. " +
@@ -15,6 +15,7 @@ type FallbackConfigPage struct {
 	masterConfig        *config.RocketPoolConfig
 	useFallbackBox      *parameterizedFormItem
 	reconnectDelay      *parameterizedFormItem
+	promotionDelay      *parameterizedFormItem
 	fallbackNormalItems []*parameterizedFormItem
 	fallbackPrysmItems  []*parameterizedFormItem
 }
@@ -74,11 +75,12 @@ func (configPage *FallbackConfigPage) createContent() {
 	// Set up the form items
 	configPage.useFallbackBox = createParameterizedCheckbox(&configPage.masterConfig.UseFallbackClients)
 	configPage.reconnectDelay = createParameterizedStringField(&configPage.masterConfig.ReconnectDelay)
+	configPage.promotionDelay = createParameterizedStringField(&configPage.masterConfig.FallbackPromotionDelay)
 	configPage.fallbackNormalItems = createParameterizedFormItems(configPage.masterConfig.FallbackNormal.GetParameters(), configPage.layout.descriptionBox)
 	configPage.fallbackPrysmItems = createParameterizedFormItems(configPage.masterConfig.FallbackPrysm.GetParameters(), configPage.layout.descriptionBox)
 
 	// Map the parameters to the form items in the layout
-	configPage.layout.mapParameterizedFormItems(configPage.useFallbackBox, configPage.reconnectDelay)
+	configPage.layout.mapParameterizedFormItems(configPage.useFallbackBox, configPage.reconnectDelay, configPage.promotionDelay)
 	configPage.layout.mapParameterizedFormItems(configPage.fallbackNormalItems...)
 	configPage.layout.mapParameterizedFormItems(configPage.fallbackPrysmItems...)
 
@@ -105,6 +107,7 @@ func (configPage *FallbackConfigPage) handleUseFallbackChanged() {
 		return
 	}
 	configPage.layout.form.AddFormItem(configPage.reconnectDelay.item)
+	configPage.layout.form.AddFormItem(configPage.promotionDelay.item)
 
 	cc, _ := configPage.masterConfig.GetSelectedConsensusClient()
 	switch cc {
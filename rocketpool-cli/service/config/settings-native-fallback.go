@@ -14,6 +14,7 @@ type NativeFallbackConfigPage struct {
 	masterConfig   *config.RocketPoolConfig
 	useFallbackBox *parameterizedFormItem
 	reconnectDelay *parameterizedFormItem
+	promotionDelay *parameterizedFormItem
 	fallbackItems  []*parameterizedFormItem
 }
 
@@ -72,10 +73,11 @@ func (configPage *NativeFallbackConfigPage) createContent() {
 	// Set up the form items
 	configPage.useFallbackBox = createParameterizedCheckbox(&configPage.masterConfig.UseFallbackClients)
 	configPage.reconnectDelay = createParameterizedStringField(&configPage.masterConfig.ReconnectDelay)
+	configPage.promotionDelay = createParameterizedStringField(&configPage.masterConfig.FallbackPromotionDelay)
 	configPage.fallbackItems = createParameterizedFormItems(configPage.masterConfig.FallbackNormal.GetParameters(), configPage.layout.descriptionBox)
 
 	// Map the parameters to the form items in the layout
-	configPage.layout.mapParameterizedFormItems(configPage.useFallbackBox, configPage.reconnectDelay)
+	configPage.layout.mapParameterizedFormItems(configPage.useFallbackBox, configPage.reconnectDelay, configPage.promotionDelay)
 	configPage.layout.mapParameterizedFormItems(configPage.fallbackItems...)
 
 	// Set up the setting callbacks
@@ -101,6 +103,7 @@ func (configPage *NativeFallbackConfigPage) handleUseFallbackChanged() {
 		return
 	}
 	configPage.layout.form.AddFormItem(configPage.reconnectDelay.item)
+	configPage.layout.form.AddFormItem(configPage.promotionDelay.item)
 	configPage.layout.addFormItems(configPage.fallbackItems)
 
 	configPage.layout.refresh()
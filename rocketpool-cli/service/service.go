@@ -214,6 +214,15 @@ func serviceStatus(c *cli.Context) error {
 
 }
 
+// Print a warning if the genesis fork version override will replace the one reported by the Beacon client
+func printGenesisForkVersionOverrideWarning(cfg *config.RocketPoolConfig) {
+	override := cfg.Smartnode.GetGenesisForkVersionOverride()
+	if override == "" {
+		return
+	}
+	fmt.Printf("%sWARNING: The genesis fork version override is set to %s, so validator deposits will be signed with it instead of the genesis fork version reported by your Beacon client. Deposits signed with the wrong fork version are invalid and the ETH sent with them will be lost.%s\n", colorYellow, override, colorReset)
+}
+
 // Configure the service
 func configureService(c *cli.Context) error {
 
@@ -279,6 +288,7 @@ func configureService(c *cli.Context) error {
 		if err != nil {
 			return fmt.Errorf("error updating config from provided arguments: %w", err)
 		}
+		printGenesisForkVersionOverrideWarning(cfg)
 		return rp.SaveConfig(cfg)
 	}
 
@@ -297,6 +307,7 @@ func configureService(c *cli.Context) error {
 		// Save the config
 		rp.SaveConfig(md.Config)
 		fmt.Println("Your changes have been saved!")
+		printGenesisForkVersionOverrideWarning(md.Config)
 
 		// Exit immediately if we're in native mode
 		if isNative {
@@ -0,0 +1,121 @@
+package wallet
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services/passwords"
+	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
+)
+
+func exportBackup(c *cli.Context, backupPath string) error {
+
+	// Get RP client
+	rp, err := rocketpool.NewClientFromCtx(c)
+	if err != nil {
+		return err
+	}
+	defer rp.Close()
+
+	// Get & check wallet status
+	status, err := rp.WalletStatus()
+	if err != nil {
+		return err
+	}
+	if !status.WalletInitialized {
+		fmt.Println("The node wallet is not initialized.")
+		return nil
+	}
+
+	// Get the backup passphrase
+	passphrase := c.String("passphrase")
+	if passphrase == "" {
+		passphrase = promptBackupPassphrase()
+	}
+
+	// Export the backup
+	response, err := rp.ExportWalletBackup(passphrase, c.Bool("include-validator-keys"))
+	if err != nil {
+		return err
+	}
+
+	// Save the backup
+	if err := ioutil.WriteFile(backupPath, []byte(response.Backup), 0600); err != nil {
+		return fmt.Errorf("Could not write backup to %s: %w", backupPath, err)
+	}
+
+	// Log & return
+	fmt.Printf("The node wallet backup was saved to %s.\n", backupPath)
+	if c.Bool("include-validator-keys") {
+		fmt.Printf("It includes %d validator key files.\n", response.ValidatorKeyFileCount)
+	}
+	fmt.Println("Store it somewhere safe, and keep its passphrase separate from it - you will need both to restore your node wallet.")
+	return nil
+
+}
+
+func importBackup(c *cli.Context, backupPath string) error {
+
+	// Get RP client
+	rp, err := rocketpool.NewClientFromCtx(c)
+	if err != nil {
+		return err
+	}
+	defer rp.Close()
+
+	// Get & check wallet status
+	status, err := rp.WalletStatus()
+	if err != nil {
+		return err
+	}
+	if status.WalletInitialized {
+		fmt.Println("The node wallet is already initialized.")
+		return nil
+	}
+
+	// Read the backup
+	backup, err := ioutil.ReadFile(backupPath)
+	if err != nil {
+		return fmt.Errorf("Could not read backup from %s: %w", backupPath, err)
+	}
+
+	// Get the backup passphrase
+	passphrase := c.String("passphrase")
+	if passphrase == "" {
+		passphrase = cliutils.PromptPassword("Please enter the passphrase for the backup:", "^.*$", "")
+	}
+
+	// Import the backup
+	response, err := rp.ImportWalletBackup(backup, passphrase, c.Bool("force"))
+	if err != nil {
+		return err
+	}
+
+	// Log & return
+	fmt.Println("The node wallet was successfully restored.")
+	if response.ValidatorKeyFileCount > 0 {
+		fmt.Printf("Restored %d validator key files. Please restart your Validator Client so it loads them.\n", response.ValidatorKeyFileCount)
+	}
+	return nil
+
+}
+
+// Prompt for a passphrase to encrypt a wallet backup with
+func promptBackupPassphrase() string {
+	for {
+		passphrase := cliutils.PromptPassword(
+			"Please enter a passphrase to encrypt the backup with. This should be different from your wallet password:",
+			fmt.Sprintf("^.{%d,}$", passwords.MinPasswordLength),
+			fmt.Sprintf("Your passphrase must be at least %d characters long. Please try again:", passwords.MinPasswordLength),
+		)
+		confirmation := cliutils.PromptPassword("Please confirm your passphrase:", "^.*$", "")
+		if passphrase == confirmation {
+			return passphrase
+		}
+		fmt.Println("Passphrase confirmation does not match.")
+		fmt.Println("")
+	}
+}
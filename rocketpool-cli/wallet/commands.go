@@ -20,7 +20,13 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 				Name:      "status",
 				Aliases:   []string{"s"},
 				Usage:     "Get the node wallet status",
-				UsageText: "rocketpool wallet status",
+				UsageText: "rocketpool wallet status [options]",
+				Flags: []cli.Flag{
+					cli.BoolFlag{
+						Name:  "details, d",
+						Usage: "Show where the daemon expects each of the wallet files to be and whether they exist",
+					},
+				},
 				Action: func(c *cli.Context) error {
 
 					// Validate args
@@ -40,6 +46,10 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 				Usage:     "Initialize the node wallet",
 				UsageText: "rocketpool wallet init [options]",
 				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "mnemonic-passphrase",
+						Usage: "An optional BIP-39 passphrase (sometimes called the 25th word) to use with the mnemonic. It is never saved, so you must provide it again every time you recover the wallet.",
+					},
 					cli.StringFlag{
 						Name:  "password, p",
 						Usage: "The password to secure the wallet with (if not already set)",
@@ -52,6 +62,10 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 						Name:  "derivation-path, d",
 						Usage: "Specify the derivation path for the wallet.\nOmit this flag (or leave it blank) for the default of \"m/44'/60'/0'/0/%d\" (where %d is the index).\nSet this to \"ledgerLive\" to use Ledger Live's path of \"m/44'/60'/%d/0/0\".\nSet this to \"mew\" to use MyEtherWallet's path of \"m/44'/60'/0'/%d\".\nFor custom paths, simply enter them here.",
 					},
+					cli.IntFlag{
+						Name:  "entropy-bits, e",
+						Usage: "The number of bits of entropy to use for the wallet's mnemonic: 256 for a 24-word mnemonic (the default) or 128 for a 12-word mnemonic.",
+					},
 				},
 				Action: func(c *cli.Context) error {
 
@@ -66,6 +80,9 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 							return err
 						}
 					}
+					if c.IsSet("entropy-bits") && c.Int("entropy-bits") != 128 && c.Int("entropy-bits") != 256 {
+						return fmt.Errorf("Invalid entropy-bits '%d' - must be 128 or 256", c.Int("entropy-bits"))
+					}
 
 					// Run
 					return initWallet(c)
@@ -79,6 +96,10 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 				Usage:     "Recover a node wallet from a mnemonic phrase",
 				UsageText: "rocketpool wallet recover [options]",
 				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "mnemonic-passphrase",
+						Usage: "An optional BIP-39 passphrase (sometimes called the 25th word) to use with the mnemonic. It is never saved, so you must provide it again every time you recover the wallet.",
+					},
 					cli.StringFlag{
 						Name:  "password, p",
 						Usage: "The password to secure the wallet with (if not already set)",
@@ -102,7 +123,7 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 					},
 					cli.StringFlag{
 						Name:  "address, a",
-						Usage: "If you are recovering a wallet that was not generated by the Smartnode and don't know the derivation path or index of it, enter the address here. The Smartnode will search through its library of paths and indices to try to find it.",
+						Usage: "If you are recovering a wallet that was not generated by the Smartnode and don't know the derivation path or index of it, enter the address here. The Smartnode will search through its library of paths and indices to try to find it. If --derivation-path is also set to a custom path, that path will be searched too.",
 					},
 				},
 				Action: func(c *cli.Context) error {
@@ -154,6 +175,10 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 				Usage:     "Test recovering a node wallet without actually generating any of the node wallet or validator key files to ensure the process works as expected",
 				UsageText: "rocketpool wallet test-recovery [options]",
 				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "mnemonic-passphrase",
+						Usage: "An optional BIP-39 passphrase (sometimes called the 25th word) to use with the mnemonic. It is never saved, so you must provide it again every time you recover the wallet.",
+					},
 					cli.StringFlag{
 						Name:  "mnemonic, m",
 						Usage: "The mnemonic phrase to recover the wallet from",
@@ -173,7 +198,7 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 					},
 					cli.StringFlag{
 						Name:  "address, a",
-						Usage: "If you are recovering a wallet that was not generated by the Smartnode and don't know the derivation path or index of it, enter the address here. The Smartnode will search through its library of paths and indices to try to find it.",
+						Usage: "If you are recovering a wallet that was not generated by the Smartnode and don't know the derivation path or index of it, enter the address here. The Smartnode will search through its library of paths and indices to try to find it. If --derivation-path is also set to a custom path, that path will be searched too.",
 					},
 				},
 				Action: func(c *cli.Context) error {
@@ -196,6 +221,67 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 				},
 			},
 
+			{
+				Name:      "export-backup",
+				Usage:     "Save the node wallet, its password, and optionally its validator keys to a single passphrase-encrypted backup file",
+				UsageText: "rocketpool wallet export-backup [options] backup-file",
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "passphrase, p",
+						Usage: "The passphrase to encrypt the backup with",
+					},
+					cli.BoolFlag{
+						Name:  "include-validator-keys, v",
+						Usage: "Include the validator keystores in the backup",
+					},
+				},
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+
+					// Validate flags
+					if c.String("passphrase") != "" {
+						if _, err := cliutils.ValidateNodePassword("passphrase", c.String("passphrase")); err != nil {
+							return err
+						}
+					}
+
+					// Run
+					return exportBackup(c, c.Args().Get(0))
+
+				},
+			},
+
+			{
+				Name:      "import-backup",
+				Usage:     "Restore the node wallet from a backup file created with export-backup",
+				UsageText: "rocketpool wallet import-backup [options] backup-file",
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "passphrase, p",
+						Usage: "The passphrase the backup was encrypted with",
+					},
+					cli.BoolFlag{
+						Name:  "force, f",
+						Usage: "Overwrite the node password and any validator key files that already exist",
+					},
+				},
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+
+					// Run
+					return importBackup(c, c.Args().Get(0))
+
+				},
+			},
+
 			{
 				Name:      "export",
 				Aliases:   []string{"e"},
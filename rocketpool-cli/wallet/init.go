@@ -55,7 +55,7 @@ func initWallet(c *cli.Context) error {
 	}
 
 	// Initialize wallet
-	response, err := rp.InitWallet(derivationPath)
+	response, err := rp.InitWallet(derivationPath, c.Int("entropy-bits"), c.String("mnemonic-passphrase"))
 	if err != nil {
 		return err
 	}
@@ -76,7 +76,7 @@ func initWallet(c *cli.Context) error {
 	}
 
 	// Do a recover to save the wallet
-	recoverResponse, err := rp.RecoverWallet(response.Mnemonic, true, derivationPath, 0)
+	recoverResponse, err := rp.RecoverWallet(response.Mnemonic, c.String("mnemonic-passphrase"), true, derivationPath, 0)
 	if err != nil {
 		return fmt.Errorf("error saving wallet: %w", err)
 	}
@@ -95,6 +95,15 @@ func recoverWallet(c *cli.Context) error {
 		address := common.HexToAddress(addressString)
 		fmt.Printf("Searching for the derivation path and index for wallet %s...\nNOTE: this may take several minutes depending on how large your wallet's index is.\n", address.Hex())
 
+		// Get the custom derivation path to include in the search; the well-known paths are always searched
+		customDerivationPath := c.String("derivation-path")
+		switch customDerivationPath {
+		case "", "ledgerLive", "mew":
+			customDerivationPath = ""
+		default:
+			fmt.Printf("Including the custom derivation path %s in the search.\n", customDerivationPath)
+		}
+
 		// Log
 		if skipValidatorKeyRecovery {
 			fmt.Println("Ignoring validator keys, searching for wallet only...")
@@ -107,7 +116,7 @@ func recoverWallet(c *cli.Context) error {
 		}
 
 		// Recover wallet
-		response, err := rp.SearchAndRecoverWallet(mnemonic, address, skipValidatorKeyRecovery)
+		response, err := rp.SearchAndRecoverWallet(mnemonic, c.String("mnemonic-passphrase"), address, skipValidatorKeyRecovery, customDerivationPath)
 		if err != nil {
 			return err
 		}
@@ -157,7 +166,7 @@ func recoverWallet(c *cli.Context) error {
 		}
 
 		// Recover wallet
-		response, err := rp.RecoverWallet(mnemonic, skipValidatorKeyRecovery, derivationPath, walletIndex)
+		response, err := rp.RecoverWallet(mnemonic, c.String("mnemonic-passphrase"), skipValidatorKeyRecovery, derivationPath, walletIndex)
 		if err != nil {
 			return err
 		}
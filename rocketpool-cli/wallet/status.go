@@ -6,6 +6,7 @@ import (
 	"github.com/urfave/cli"
 
 	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	"github.com/rocket-pool/smartnode/shared/types/api"
 	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
 )
 
@@ -37,6 +38,29 @@ func getStatus(c *cli.Context) error {
 	} else {
 		fmt.Println("The node wallet has not been initialized.")
 	}
+
+	// Print the file details if requested
+	if c.Bool("details") {
+		details, err := rp.WalletStatusDetails()
+		if err != nil {
+			return err
+		}
+		fmt.Println()
+		printArtifactStatus("Wallet file", details.Wallet)
+		printArtifactStatus("Password file", details.Password)
+		fmt.Println("Note: these paths are inside the node's daemon container, which mounts your Smartnode data directory.")
+	}
 	return nil
 
 }
+
+// Print the location and state of one of the wallet files
+func printArtifactStatus(name string, status api.WalletArtifactStatus) {
+	if status.ReadError != "" {
+		fmt.Printf("%s: %sUNREADABLE%s at %s (%s)\n", name, colorYellow, colorReset, status.Path, status.ReadError)
+	} else if !status.Exists {
+		fmt.Printf("%s: %sMISSING%s (expected at %s)\n", name, colorRed, colorReset, status.Path)
+	} else {
+		fmt.Printf("%s: %sOK%s at %s\n", name, colorGreen, colorReset, status.Path)
+	}
+}
@@ -79,6 +79,15 @@ func testRecovery(c *cli.Context) error {
 		address := common.HexToAddress(addressString)
 		fmt.Printf("Searching for the derivation path and index for wallet %s...\nNOTE: this may take several minutes depending on how large your wallet's index is.\n", address.Hex())
 
+		// Get the custom derivation path to include in the search; the well-known paths are always searched
+		customDerivationPath := c.String("derivation-path")
+		switch customDerivationPath {
+		case "", "ledgerLive", "mew":
+			customDerivationPath = ""
+		default:
+			fmt.Printf("Including the custom derivation path %s in the search.\n", customDerivationPath)
+		}
+
 		// Log
 		if skipValidatorKeyRecovery {
 			fmt.Println("Ignoring validator keys, searching for wallet only...")
@@ -91,7 +100,7 @@ func testRecovery(c *cli.Context) error {
 		}
 
 		// Test recover wallet
-		response, err := rp.TestSearchAndRecoverWallet(mnemonic, address, skipValidatorKeyRecovery)
+		response, err := rp.TestSearchAndRecoverWallet(mnemonic, c.String("mnemonic-passphrase"), address, skipValidatorKeyRecovery, customDerivationPath)
 		if err != nil {
 			return err
 		}
@@ -141,7 +150,7 @@ func testRecovery(c *cli.Context) error {
 		}
 
 		// Test recover wallet
-		response, err := rp.TestRecoverWallet(mnemonic, skipValidatorKeyRecovery, derivationPath, walletIndex)
+		response, err := rp.TestRecoverWallet(mnemonic, c.String("mnemonic-passphrase"), skipValidatorKeyRecovery, derivationPath, walletIndex)
 		if err != nil {
 			return err
 		}
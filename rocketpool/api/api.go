@@ -1,6 +1,10 @@
 package api
 
 import (
+	"fmt"
+	"os"
+	"strings"
+
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/rocket-pool/smartnode/rocketpool/api/debug"
 	"github.com/urfave/cli"
@@ -12,6 +16,7 @@ import (
 	"github.com/rocket-pool/smartnode/rocketpool/api/network"
 	"github.com/rocket-pool/smartnode/rocketpool/api/node"
 	"github.com/rocket-pool/smartnode/rocketpool/api/odao"
+	"github.com/rocket-pool/smartnode/rocketpool/api/pdao"
 	"github.com/rocket-pool/smartnode/rocketpool/api/queue"
 	apiservice "github.com/rocket-pool/smartnode/rocketpool/api/service"
 	"github.com/rocket-pool/smartnode/rocketpool/api/wallet"
@@ -41,6 +46,116 @@ func waitForTransaction(c *cli.Context, hash common.Hash) (*apitypes.APIResponse
 
 }
 
+// Transaction-building routes that honor the idempotency key, by subcommand group
+var idempotentRoutes = map[string][]string{
+	"auction":  {"create-lot", "bid-lot", "claim-lot", "recover-lot"},
+	"faucet":   {"withdraw-rpl"},
+	"minipool": {"stake", "refund", "dissolve", "close", "finalize", "delegate-upgrade", "delegate-rollback", "set-use-latest-delegate", "set-use-latest-delegate-bulk"},
+	"node": {"register", "set-withdrawal-address", "confirm-withdrawal-address", "set-timezone", "swap-rpl-approve-rpl", "wait-and-swap-rpl", "swap-rpl", "stake-rpl-approve-rpl", "wait-and-stake-rpl", "stake-rpl", "withdraw-rpl",
+		"deposit", "send", "burn", "claim-rpl-rewards", "set-snapshot-delegate", "clear-snapshot-delegate", "initialize-fee-distributor", "distribute", "claim-rewards", "claim-and-stake-rewards"},
+	"odao": {"propose-invite", "propose-leave", "propose-kick", "cancel-proposal", "vote-proposal", "execute-proposal", "join-approve-rpl", "join", "leave",
+		"propose-members-quorum", "propose-members-rplbond", "propose-members-minipool-unbonded-max", "propose-proposal-cooldown", "propose-proposal-vote-timespan",
+		"propose-proposal-vote-delay-timespan", "propose-proposal-execute-timespan", "propose-proposal-action-timespan", "propose-scrub-period"},
+	"pdao": {"bootstrap-setting"},
+}
+
+// Wrap the transaction-building routes so a retried call with the same idempotency key returns the original response
+func registerIdempotentRoutes(command *cli.Command) {
+	for i := range command.Subcommands {
+		group := &command.Subcommands[i]
+		routes, exists := idempotentRoutes[group.Name]
+		if !exists {
+			continue
+		}
+		for j := range group.Subcommands {
+			route := &group.Subcommands[j]
+			for _, name := range routes {
+				if route.Name != name {
+					continue
+				}
+				// Routes with other action signatures can't be wrapped, so they're left as-is
+				if action, ok := route.Action.(func(*cli.Context) error); ok {
+					route.Action = idempotentAction(group.Name+" "+route.Name, action)
+				}
+				break
+			}
+		}
+	}
+}
+
+// Wrap the routes that always read the chain head so they reject the finalized flag instead of silently ignoring it
+func registerFinalizedStateRoutes(command *cli.Command) {
+	for i := range command.Subcommands {
+		group := &command.Subcommands[i]
+		for j := range group.Subcommands {
+			route := &group.Subcommands[j]
+			routeName := group.Name + " " + route.Name
+			if apitypes.FinalizedStateRoutes[routeName] {
+				continue
+			}
+			if action, ok := route.Action.(func(*cli.Context) error); ok {
+				route.Action = finalizedStateUnsupportedAction(routeName, action)
+			}
+		}
+	}
+}
+
+// Create an action that fails if the finalized flag is set
+func finalizedStateUnsupportedAction(routeName string, action func(*cli.Context) error) func(*cli.Context) error {
+	return func(c *cli.Context) error {
+		if c.GlobalBool("finalized") {
+			return fmt.Errorf("The '%s' command does not support the --finalized flag; it can only read the chain head.", routeName)
+		}
+		return action(c)
+	}
+}
+
+// Create an action that replays the previous response for a recently used idempotency key
+func idempotentAction(routeName string, action func(*cli.Context) error) func(*cli.Context) error {
+	return func(c *cli.Context) error {
+
+		// Run normally if there's no key
+		key := c.GlobalString("idempotency-key")
+		if key == "" {
+			return action(c)
+		}
+
+		// Get the cache
+		cfg, err := services.GetConfig(c)
+		if err != nil {
+			return err
+		}
+		cache := api.NewIdempotencyCache(cfg.Smartnode.GetIdempotencyCachePath())
+		cacheKey := fmt.Sprintf("%s %s:%s", routeName, strings.Join(c.Args(), " "), key)
+
+		// Make sure no other call with this key is running concurrently
+		unlock, err := cache.Lock(cacheKey)
+		if err != nil {
+			return err
+		}
+		defer unlock()
+
+		// Replay the previous response if there is one
+		response, exists, err := cache.Get(cacheKey)
+		if err != nil {
+			return err
+		}
+		if exists {
+			fmt.Println(string(response))
+			return nil
+		}
+
+		// Record the response for future retries
+		api.SetResponseRecorder(func(responseBytes []byte) {
+			if err := cache.Set(cacheKey, responseBytes); err != nil {
+				fmt.Fprintf(os.Stderr, "WARNING: %s\n", err.Error())
+			}
+		})
+		return action(c)
+
+	}
+}
+
 // Register commands
 func RegisterCommands(app *cli.App, name string, aliases []string) {
 
@@ -64,11 +179,18 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 	network.RegisterSubcommands(&command, "network", []string{"e"})
 	node.RegisterSubcommands(&command, "node", []string{"n"})
 	odao.RegisterSubcommands(&command, "odao", []string{"o"})
+	pdao.RegisterSubcommands(&command, "pdao", []string{"p"})
 	queue.RegisterSubcommands(&command, "queue", []string{"q"})
 	wallet.RegisterSubcommands(&command, "wallet", []string{"w"})
 	apiservice.RegisterSubcommands(&command, "service", []string{"s"})
 	debug.RegisterSubcommands(&command, "debug", []string{"d"})
 
+	// Enable idempotency keys on the transaction-building routes
+	registerIdempotentRoutes(&command)
+
+	// Reject the finalized flag on the routes that don't support it
+	registerFinalizedStateRoutes(&command)
+
 	// Append a general wait-for-transaction command to support async operations
 	command.Subcommands = append(command.Subcommands, cli.Command{
 		Name:      "wait",
@@ -0,0 +1,189 @@
+package minipool
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/rocketpool-go/minipool"
+	"github.com/rocket-pool/rocketpool-go/rocketpool"
+	"github.com/rocket-pool/rocketpool-go/types"
+	"github.com/rocket-pool/rocketpool-go/utils/eth"
+	"github.com/urfave/cli"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+	rputils "github.com/rocket-pool/smartnode/shared/utils/rp"
+)
+
+// The number of seconds in a year, for annualizing rewards
+const secondsPerYear float64 = 365.25 * 24 * 60 * 60
+
+func getMinipoolBalanceSplits(c *cli.Context) (*api.GetMinipoolBalanceSplitsResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeRegistered(c); err != nil {
+		return nil, err
+	}
+	if err := services.RequireBeaconClientSynced(c); err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.GetMinipoolBalanceSplitsResponse{
+		Splits: []api.MinipoolBalanceSplit{},
+	}
+
+	// Get the node's minipools
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+	addresses, err := minipool.GetNodeMinipoolAddresses(rp, nodeAccount.Address, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the Beacon Chain config and current epoch
+	eth2Config, err := bc.GetEth2Config()
+	if err != nil {
+		return nil, err
+	}
+	head, err := bc.GetBeaconHead()
+	if err != nil {
+		return nil, err
+	}
+
+	// Get minipool validator statuses
+	validators, err := rputils.GetMinipoolValidators(rp, bc, addresses, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Load splits in batches
+	splits := make([]*api.MinipoolBalanceSplit, len(addresses))
+	for bsi := 0; bsi < len(addresses); bsi += MinipoolDetailsBatchSize {
+
+		// Get batch start & end index
+		msi := bsi
+		mei := bsi + MinipoolDetailsBatchSize
+		if mei > len(addresses) {
+			mei = len(addresses)
+		}
+
+		// Load splits
+		var wg errgroup.Group
+		for mi := msi; mi < mei; mi++ {
+			mi := mi
+			wg.Go(func() error {
+				address := addresses[mi]
+				split, err := getMinipoolBalanceSplit(rp, address, validators[address], eth2Config, head.Epoch, nil)
+				if err == nil {
+					splits[mi] = split
+				}
+				return err
+			})
+		}
+		if err := wg.Wait(); err != nil {
+			return nil, err
+		}
+
+	}
+
+	// Only report staking minipools
+	for _, split := range splits {
+		if split != nil {
+			response.Splits = append(response.Splits, *split)
+		}
+	}
+
+	// Return response
+	return &response, nil
+
+}
+
+// Get how a staking minipool's balance would be split between the node and rETH; returns nil if the minipool isn't staking yet
+func getMinipoolBalanceSplit(rp *rocketpool.RocketPool, minipoolAddress common.Address, validator beacon.ValidatorStatus, eth2Config beacon.Eth2Config, currentEpoch uint64, opts *bind.CallOpts) (*api.MinipoolBalanceSplit, error) {
+
+	// Ignore minipools that don't have an active validator
+	if !validator.Exists || validator.ActivationEpoch >= currentEpoch {
+		return nil, nil
+	}
+
+	// Create minipool
+	mp, err := minipool.NewMinipool(rp, minipoolAddress)
+	if err != nil {
+		return nil, err
+	}
+	status, err := mp.GetStatus(opts)
+	if err != nil {
+		return nil, err
+	}
+	if status != types.Staking {
+		return nil, nil
+	}
+
+	// Data
+	var wg errgroup.Group
+	split := api.MinipoolBalanceSplit{
+		Address:          minipoolAddress,
+		Balance:          eth.GweiToWei(float64(validator.Balance)),
+		EffectiveBalance: eth.GweiToWei(float64(validator.EffectiveBalance)),
+	}
+	var balanceNodeShare *big.Int
+
+	// Load data
+	wg.Go(func() error {
+		var err error
+		split.NodeFee, err = mp.GetNodeFee(opts)
+		return err
+	})
+	wg.Go(func() error {
+		var err error
+		split.NodeDepositBalance, err = mp.GetNodeDepositBalance(opts)
+		return err
+	})
+	wg.Go(func() error {
+		var err error
+		split.NodeShare, err = mp.CalculateNodeShare(split.EffectiveBalance, opts)
+		return err
+	})
+	wg.Go(func() error {
+		var err error
+		balanceNodeShare, err = mp.CalculateNodeShare(split.Balance, opts)
+		return err
+	})
+
+	// Wait for data
+	if err := wg.Wait(); err != nil {
+		return nil, err
+	}
+	split.RethShare = big.NewInt(0).Sub(split.EffectiveBalance, split.NodeShare)
+
+	// Annualize the node's share of the validator's rewards so far against its bond
+	activationTime := eth2Config.GenesisTime + validator.ActivationEpoch*eth2Config.SecondsPerEpoch
+	elapsed := time.Since(time.Unix(int64(activationTime), 0)).Seconds()
+	if elapsed > 0 && split.NodeDepositBalance.Sign() > 0 {
+		nodeRewards := eth.WeiToEth(balanceNodeShare) - eth.WeiToEth(split.NodeDepositBalance)
+		split.NodeApr = nodeRewards / eth.WeiToEth(split.NodeDepositBalance) / (elapsed / secondsPerYear)
+	}
+
+	// Return
+	return &split, nil
+
+}
@@ -0,0 +1,106 @@
+package minipool
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/rocketpool-go/minipool"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+	rputils "github.com/rocket-pool/smartnode/shared/utils/rp"
+)
+
+// The largest number of slots that can be scanned in one call, since each one requires a Beacon block request
+const maxBeaconWithdrawalsSlotRange uint64 = 1024
+
+func getBeaconWithdrawals(c *cli.Context, startSlot uint64, endSlot uint64) (*api.GetMinipoolBeaconWithdrawalsResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeRegistered(c); err != nil {
+		return nil, err
+	}
+	if err := services.RequireBeaconClientSynced(c); err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check the slot range
+	if endSlot < startSlot {
+		return nil, fmt.Errorf("The end slot (%d) cannot be before the start slot (%d).", endSlot, startSlot)
+	}
+	if endSlot-startSlot >= maxBeaconWithdrawalsSlotRange {
+		return nil, fmt.Errorf("The slot range %d - %d is too large; at most %d slots can be checked at once.", startSlot, endSlot, maxBeaconWithdrawalsSlotRange)
+	}
+
+	// Response
+	response := api.GetMinipoolBeaconWithdrawalsResponse{
+		StartSlot:   startSlot,
+		EndSlot:     endSlot,
+		Withdrawals: map[common.Address]api.MinipoolBeaconWithdrawals{},
+	}
+
+	// Get node account
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the validator indices of the node's minipools
+	addresses, err := minipool.GetNodeMinipoolAddresses(rp, nodeAccount.Address, nil)
+	if err != nil {
+		return nil, err
+	}
+	validators, err := rputils.GetMinipoolValidators(rp, bc, addresses, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	minipoolsByIndex := map[uint64]common.Address{}
+	for _, address := range addresses {
+		validator := validators[address]
+		if !validator.Exists {
+			continue
+		}
+		minipoolsByIndex[validator.Index] = address
+		response.Withdrawals[address] = api.MinipoolBeaconWithdrawals{
+			ValidatorIndex: validator.Index,
+		}
+	}
+
+	// Sum the withdrawals for the node's validators in each block
+	for slot := startSlot; slot <= endSlot; slot++ {
+		block, exists, err := bc.GetBeaconBlock(fmt.Sprint(slot))
+		if err != nil {
+			return nil, fmt.Errorf("Error getting Beacon block for slot %d: %w", slot, err)
+		}
+		if !exists {
+			continue
+		}
+		for _, withdrawal := range block.Withdrawals {
+			address, exists := minipoolsByIndex[withdrawal.ValidatorIndex]
+			if !exists {
+				continue
+			}
+			withdrawals := response.Withdrawals[address]
+			withdrawals.TotalGwei += withdrawal.Amount
+			withdrawals.Count++
+			response.Withdrawals[address] = withdrawals
+		}
+	}
+
+	// Return response
+	return &response, nil
+
+}
@@ -1,6 +1,10 @@
 package minipool
 
 import (
+	"fmt"
+	"strings"
+
+	"github.com/rocket-pool/rocketpool-go/types"
 	"github.com/urfave/cli"
 
 	"github.com/rocket-pool/smartnode/shared/utils/api"
@@ -254,6 +258,88 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 				},
 			},
 
+			{
+				Name:      "export-deposit-data",
+				Usage:     "Export the minipool's validator deposit data in the staking-deposit-cli layout; the amount is the minipool's deposit, not 32 ETH, so it can't be used with the Launchpad",
+				UsageText: "rocketpool api minipool export-deposit-data minipool-address",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+					minipoolAddress, err := cliutils.ValidateAddress("minipool address", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(exportDepositData(c, minipoolAddress))
+					return nil
+
+				},
+			},
+
+			{
+				Name:      "get-beacon-withdrawals",
+				Usage:     "Get the total Beacon Chain withdrawals received by each of the node's minipools over a range of up to 1024 slots",
+				UsageText: "rocketpool api minipool get-beacon-withdrawals start-slot end-slot",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 2); err != nil {
+						return err
+					}
+					startSlot, err := cliutils.ValidateUint("start slot", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+					endSlot, err := cliutils.ValidateUint("end slot", c.Args().Get(1))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(getBeaconWithdrawals(c, startSlot, endSlot))
+					return nil
+
+				},
+			},
+
+			{
+				Name:      "get-finalize-details",
+				Usage:     "Check which of the node's minipools are ready to be finalized",
+				UsageText: "rocketpool api minipool get-finalize-details",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(getMinipoolFinaliseDetails(c))
+					return nil
+
+				},
+			},
+			{
+				Name:      "get-balance-splits",
+				Usage:     "Preview how each of the node's staking minipools' balances would be split between the node and rETH",
+				UsageText: "rocketpool api minipool get-balance-splits",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(getMinipoolBalanceSplits(c))
+					return nil
+
+				},
+			},
 			{
 				Name:      "can-finalize",
 				Usage:     "Check whether the minipool can be finalized",
@@ -434,6 +520,48 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 
 				},
 			},
+			{
+				Name:      "can-set-use-latest-delegate-bulk",
+				Usage:     "Check whether the 'always use latest delegate' toggle can be set on all of the node's minipools, and estimate the gas for it",
+				UsageText: "rocketpool api minipool can-set-use-latest-delegate-bulk setting",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+					setting, err := cliutils.ValidateBool("setting", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(canSetUseLatestDelegateBulk(c, setting))
+					return nil
+
+				},
+			},
+			{
+				Name:      "set-use-latest-delegate-bulk",
+				Usage:     "Set the 'always use latest delegate' toggle on all of the node's minipools, sending one transaction per minipool that needs it",
+				UsageText: "rocketpool api minipool set-use-latest-delegate-bulk setting",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+					setting, err := cliutils.ValidateBool("setting", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(setUseLatestDelegateBulk(c, setting))
+					return nil
+
+				},
+			},
 
 			{
 				Name:      "get-use-latest-delegate",
@@ -523,6 +651,80 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 				},
 			},
 
+			{
+				Name:      "get-queue-position",
+				Usage:     "Get the minipool's position in the deposit assignment queue",
+				UsageText: "rocketpool api minipool get-queue-position minipool-address",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+					minipoolAddress, err := cliutils.ValidateAddress("minipool address", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(getQueuePosition(c, minipoolAddress))
+					return nil
+
+				},
+			},
+
+			{
+				Name:      "get-exit-messages",
+				Usage:     "Generate presigned voluntary exit messages for the given validators (or all of the node's validating minipools) at the current epoch without broadcasting them. These can exit the validators at any time, so store them securely. They're signed for the current fork and stop being valid two hard forks later, so regenerate them after every hard fork.",
+				UsageText: "rocketpool api minipool get-exit-messages [pubkeys]",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if c.NArg() > 1 {
+						return fmt.Errorf("Incorrect argument count; usage: %s", c.Command.UsageText)
+					}
+					pubkeys := []types.ValidatorPubkey{}
+					if c.NArg() == 1 {
+						for _, pubkeyString := range strings.Split(c.Args().Get(0), ",") {
+							pubkey, err := cliutils.ValidatePubkey("pubkey", pubkeyString)
+							if err != nil {
+								return err
+							}
+							pubkeys = append(pubkeys, pubkey)
+						}
+					}
+
+					// Run
+					api.PrintResponse(getExitMessages(c, pubkeys))
+					return nil
+
+				},
+			},
+
+			{
+				Name:      "import-key",
+				Usage:     "Import the validator key for a minipool from an encrypted EIP-2335 keystore",
+				UsageText: "rocketpool api minipool import-key minipool-address keystore-path password-path",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 3); err != nil {
+						return err
+					}
+					minipoolAddress, err := cliutils.ValidateAddress("minipool address", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+					keystorePath := c.Args().Get(1)
+					passwordPath := c.Args().Get(2)
+
+					// Run
+					api.PrintResponse(importKey(c, minipoolAddress, keystorePath, passwordPath))
+					return nil
+
+				},
+			},
+
 			{
 				Name:      "get-vanity-artifacts",
 				Aliases:   []string{"v"},
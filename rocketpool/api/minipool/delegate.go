@@ -1,7 +1,9 @@
 package minipool
 
 import (
+	"context"
 	"fmt"
+	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/rocket-pool/rocketpool-go/minipool"
@@ -413,3 +415,172 @@ func getEffectiveDelegate(c *cli.Context, minipoolAddress common.Address) (*api.
 	return &response, nil
 
 }
+
+func canSetUseLatestDelegateBulk(c *cli.Context, setting bool) (*api.CanSetUseLatestDelegateBulkResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeRegistered(c); err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.CanSetUseLatestDelegateBulkResponse{
+		Minipools: []api.MinipoolCanSetUseLatestDelegateResult{},
+	}
+
+	// Get the node's minipools
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+	addresses, err := minipool.GetNodeMinipoolAddresses(rp, nodeAccount.Address, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get gas estimate
+	opts, err := w.GetNodeAccountTransactor()
+	if err != nil {
+		return nil, err
+	}
+
+	// Estimate the gas for each minipool that doesn't already have the setting
+	for _, address := range addresses {
+		result := api.MinipoolCanSetUseLatestDelegateResult{
+			Address: address,
+		}
+
+		mp, err := minipool.NewMinipool(rp, address)
+		if err != nil {
+			return nil, err
+		}
+		currentSetting, err := mp.GetUseLatestDelegate(nil)
+		if err != nil {
+			return nil, fmt.Errorf("Error getting the current setting for minipool %s: %w", address.Hex(), err)
+		}
+		if currentSetting == setting {
+			result.AlreadySet = true
+			response.Minipools = append(response.Minipools, result)
+			continue
+		}
+
+		gasInfo, err := mp.EstimateSetUseLatestDelegateGas(setting, opts)
+		if err == nil {
+			result.GasInfo = gasInfo
+			response.GasInfo.EstGasLimit += gasInfo.EstGasLimit
+			response.GasInfo.SafeGasLimit += gasInfo.SafeGasLimit
+		}
+		response.Minipools = append(response.Minipools, result)
+	}
+
+	// Return response
+	return &response, nil
+
+}
+
+func setUseLatestDelegateBulk(c *cli.Context, setting bool) (*api.SetUseLatestDelegateBulkResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeRegistered(c); err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.SetUseLatestDelegateBulkResponse{
+		Minipools: []api.MinipoolSetUseLatestDelegateResult{},
+	}
+
+	// Get the node's minipools
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+	addresses, err := minipool.GetNodeMinipoolAddresses(rp, nodeAccount.Address, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get transactor
+	opts, err := w.GetNodeAccountTransactor()
+	if err != nil {
+		return nil, err
+	}
+
+	// Override the provided pending TX if requested, otherwise start from the next available nonce
+	err = eth1.CheckForNonceOverride(c, opts)
+	if err != nil {
+		return nil, fmt.Errorf("Error checking for nonce override: %w", err)
+	}
+	if opts.Nonce == nil {
+		nonce, err := rp.Client.PendingNonceAt(context.Background(), nodeAccount.Address)
+		if err != nil {
+			return nil, fmt.Errorf("Error getting the next available nonce: %w", err)
+		}
+		opts.Nonce = big.NewInt(0).SetUint64(nonce)
+	}
+
+	// Set the new setting on each minipool that doesn't already have it, giving each TX the next nonce.
+	// A failure is recorded on that minipool so the TXs that were already sent are still returned.
+	for _, address := range addresses {
+		result := api.MinipoolSetUseLatestDelegateResult{
+			Address: address,
+		}
+
+		mp, err := minipool.NewMinipool(rp, address)
+		if err != nil {
+			result.Error = err.Error()
+			response.Minipools = append(response.Minipools, result)
+			continue
+		}
+		currentSetting, err := mp.GetUseLatestDelegate(nil)
+		if err != nil {
+			result.Error = fmt.Sprintf("Error getting the current setting: %s", err.Error())
+			response.Minipools = append(response.Minipools, result)
+			continue
+		}
+		if currentSetting == setting {
+			result.AlreadySet = true
+			response.Minipools = append(response.Minipools, result)
+			continue
+		}
+
+		hash, err := mp.SetUseLatestDelegate(setting, opts)
+		if err != nil {
+			result.Error = fmt.Sprintf("Error setting use latest delegate: %s", err.Error())
+
+			// The nonce may not have been used, so pick up from whatever the client has seen next; stop if that isn't known
+			nonce, err := rp.Client.PendingNonceAt(context.Background(), nodeAccount.Address)
+			if err != nil {
+				result.Error += fmt.Sprintf(" (stopping because the next available nonce couldn't be retrieved: %s)", err.Error())
+				response.Minipools = append(response.Minipools, result)
+				break
+			}
+			response.Minipools = append(response.Minipools, result)
+			opts.Nonce = big.NewInt(0).SetUint64(nonce)
+			continue
+		}
+		result.TxHash = hash
+		response.Minipools = append(response.Minipools, result)
+		opts.Nonce = big.NewInt(0).Add(opts.Nonce, big.NewInt(1))
+	}
+
+	// Return response
+	return &response, nil
+
+}
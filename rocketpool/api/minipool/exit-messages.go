@@ -0,0 +1,122 @@
+package minipool
+
+import (
+	"encoding/hex"
+	"strconv"
+
+	"github.com/rocket-pool/rocketpool-go/minipool"
+	"github.com/rocket-pool/rocketpool-go/types"
+	"github.com/urfave/cli"
+	eth2types "github.com/wealdtech/go-eth2-types/v2"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+	hexutil "github.com/rocket-pool/smartnode/shared/utils/hex"
+	"github.com/rocket-pool/smartnode/shared/utils/validator"
+)
+
+// Get presigned voluntary exit messages for the given validators at the current epoch, without broadcasting them
+// If no pubkeys are provided, messages are generated for all of the node's validating minipools
+func getExitMessages(c *cli.Context, pubkeys []types.ValidatorPubkey) (*api.GetMinipoolExitMessagesResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeRegistered(c); err != nil {
+		return nil, err
+	}
+	if err := services.RequireBeaconClientSynced(c); err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.GetMinipoolExitMessagesResponse{
+		ExitMessages:     []api.MinipoolExitMessage{},
+		NotOnBeaconChain: []types.ValidatorPubkey{},
+		KeyNotFound:      []types.ValidatorPubkey{},
+	}
+
+	// Get the node's validator pubkeys if none were provided
+	if len(pubkeys) == 0 {
+		nodeAccount, err := w.GetNodeAccount()
+		if err != nil {
+			return nil, err
+		}
+		pubkeys, err = minipool.GetNodeValidatingMinipoolPubkeys(rp, nodeAccount.Address, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Get beacon head
+	head, err := bc.GetBeaconHead()
+	if err != nil {
+		return nil, err
+	}
+	response.Epoch = head.Epoch
+
+	// Get voluntary exit signature domain.
+	// Exits are signed with the current fork's version, and the Beacon chain only accepts signatures from the current and previous forks,
+	// so the messages stop being valid two hard forks later and have to be regenerated after each one.
+	signatureDomain, err := bc.GetDomainData(eth2types.DomainVoluntaryExit[:], head.Epoch)
+	if err != nil {
+		return nil, err
+	}
+	forkVersion, err := bc.GetForkVersion(head.Epoch)
+	if err != nil {
+		return nil, err
+	}
+	response.ForkVersion = hexutil.AddPrefix(hex.EncodeToString(forkVersion))
+	response.Warning = "These exit messages are signed for the current fork and will stop being valid after the next two hard forks; regenerate them after every hard fork."
+
+	// Get the validator indices
+	statuses, err := bc.GetValidatorStatuses(pubkeys, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Sign an exit message for each validator
+	for _, pubkey := range pubkeys {
+		status, exists := statuses[pubkey]
+		if !exists || !status.Exists {
+			response.NotOnBeaconChain = append(response.NotOnBeaconChain, pubkey)
+			continue
+		}
+
+		// Imported keys are loaded from the validator keystores; skip any validator the node doesn't have a key for
+		validatorKey, _, err := w.LoadValidatorKey(pubkey)
+		if err != nil {
+			return nil, err
+		}
+		if validatorKey == nil {
+			response.KeyNotFound = append(response.KeyNotFound, pubkey)
+			continue
+		}
+		signature, err := validator.GetSignedExitMessage(validatorKey, status.Index, head.Epoch, signatureDomain)
+		if err != nil {
+			return nil, err
+		}
+
+		exitMessage := api.MinipoolExitMessage{
+			Pubkey: pubkey,
+		}
+		exitMessage.SignedMessage.Message.Epoch = strconv.FormatUint(head.Epoch, 10)
+		exitMessage.SignedMessage.Message.ValidatorIndex = strconv.FormatUint(status.Index, 10)
+		exitMessage.SignedMessage.Signature = hexutil.AddPrefix(hex.EncodeToString(signature.Bytes()))
+		response.ExitMessages = append(response.ExitMessages, exitMessage)
+	}
+
+	// Return response
+	return &response, nil
+
+}
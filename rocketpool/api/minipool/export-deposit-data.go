@@ -0,0 +1,123 @@
+package minipool
+
+import (
+	"encoding/hex"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/rocketpool-go/minipool"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+	cfgtypes "github.com/rocket-pool/smartnode/shared/types/config"
+	"github.com/rocket-pool/smartnode/shared/types/eth2"
+	"github.com/rocket-pool/smartnode/shared/utils/validator"
+)
+
+func exportDepositData(c *cli.Context, minipoolAddress common.Address) (*api.ExportMinipoolDepositDataResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeRegistered(c); err != nil {
+		return nil, err
+	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.ExportMinipoolDepositDataResponse{}
+
+	// Create minipool
+	mp, err := minipool.NewMinipool(rp, minipoolAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate minipool owner
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+	if err := validateMinipoolOwner(mp, nodeAccount.Address); err != nil {
+		return nil, err
+	}
+
+	// Get eth2 config
+	eth2Config, err := validator.GetDepositEth2Config(cfg, bc)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get minipool withdrawal credentials
+	withdrawalCredentials, err := minipool.GetMinipoolWithdrawalCredentials(rp, mp.Address, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the validator key for the minipool
+	validatorPubkey, err := minipool.GetMinipoolPubkey(rp, mp.Address, nil)
+	if err != nil {
+		return nil, err
+	}
+	validatorKey, err := w.GetValidatorKeyByPubkey(validatorPubkey)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get validator deposit data
+	depositData, depositDataRoot, err := validator.GetDepositData(validatorKey, withdrawalCredentials, eth2Config)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the deposit message root
+	depositMessage := eth2.DepositDataNoSignature{
+		PublicKey:             depositData.PublicKey,
+		WithdrawalCredentials: depositData.WithdrawalCredentials,
+		Amount:                depositData.Amount,
+	}
+	depositMessageRoot, err := depositMessage.HashTreeRoot()
+	if err != nil {
+		return nil, err
+	}
+
+	// Build the deposit data in the staking-deposit-cli layout.
+	// The amount is the minipool's own deposit rather than a full 32 ETH deposit, so this is a record of the minipool's deposit, not a file for the Launchpad.
+	response.DepositData = []api.StakingDepositData{{
+		Pubkey:                hex.EncodeToString(depositData.PublicKey),
+		WithdrawalCredentials: hex.EncodeToString(depositData.WithdrawalCredentials),
+		Amount:                depositData.Amount,
+		Signature:             hex.EncodeToString(depositData.Signature),
+		DepositMessageRoot:    hex.EncodeToString(depositMessageRoot[:]),
+		DepositDataRoot:       hex.EncodeToString(depositDataRoot.Bytes()),
+		ForkVersion:           hex.EncodeToString(eth2Config.GenesisForkVersion),
+		NetworkName:           getDepositCliNetworkName(cfg.Smartnode.Network.Value.(cfgtypes.Network)),
+	}}
+
+	// Return response
+	return &response, nil
+
+}
+
+// Get the name staking-deposit-cli uses for a network, which doesn't always match the Smartnode's
+func getDepositCliNetworkName(network cfgtypes.Network) string {
+	switch network {
+	case cfgtypes.Network_Prater:
+		return "goerli"
+	default:
+		return string(network)
+	}
+}
@@ -0,0 +1,178 @@
+package minipool
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/rocketpool-go/minipool"
+	"github.com/rocket-pool/rocketpool-go/rocketpool"
+	"github.com/rocket-pool/rocketpool-go/types"
+	"github.com/urfave/cli"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+	rputils "github.com/rocket-pool/smartnode/shared/utils/rp"
+)
+
+func getMinipoolFinaliseDetails(c *cli.Context) (*api.GetMinipoolFinaliseDetailsResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeRegistered(c); err != nil {
+		return nil, err
+	}
+	if err := services.RequireBeaconClientSynced(c); err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.GetMinipoolFinaliseDetailsResponse{}
+
+	// Get the node's minipools
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+	opts, statusOpts, err := services.GetQueryOpts(c)
+	if err != nil {
+		return nil, err
+	}
+	addresses, err := minipool.GetNodeMinipoolAddresses(rp, nodeAccount.Address, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the current epoch
+	var currentEpoch uint64
+	if statusOpts == nil {
+		head, err := bc.GetBeaconHead()
+		if err != nil {
+			return nil, err
+		}
+		currentEpoch = head.Epoch
+	} else {
+		eth2Config, err := bc.GetEth2Config()
+		if err != nil {
+			return nil, err
+		}
+		currentEpoch = *statusOpts.Slot / eth2Config.SlotsPerEpoch
+	}
+
+	// Get minipool validator statuses
+	validators, err := rputils.GetMinipoolValidators(rp, bc, addresses, opts, statusOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	// Load details in batches
+	details := make([]api.MinipoolFinaliseDetails, len(addresses))
+	for bsi := 0; bsi < len(addresses); bsi += MinipoolDetailsBatchSize {
+
+		// Get batch start & end index
+		msi := bsi
+		mei := bsi + MinipoolDetailsBatchSize
+		if mei > len(addresses) {
+			mei = len(addresses)
+		}
+
+		// Load details
+		var wg errgroup.Group
+		for mi := msi; mi < mei; mi++ {
+			mi := mi
+			wg.Go(func() error {
+				address := addresses[mi]
+				mpDetails, err := getMinipoolFinaliseDetailsForMinipool(rp, address, validators[address], currentEpoch, opts)
+				if err == nil {
+					details[mi] = mpDetails
+				}
+				return err
+			})
+		}
+		if err := wg.Wait(); err != nil {
+			return nil, err
+		}
+
+	}
+	response.Details = details
+
+	// Return response
+	return &response, nil
+
+}
+
+// Get a minipool's finalization readiness
+func getMinipoolFinaliseDetailsForMinipool(rp *rocketpool.RocketPool, minipoolAddress common.Address, validator beacon.ValidatorStatus, currentEpoch uint64, opts *bind.CallOpts) (api.MinipoolFinaliseDetails, error) {
+
+	// Create minipool
+	mp, err := minipool.NewMinipool(rp, minipoolAddress)
+	if err != nil {
+		return api.MinipoolFinaliseDetails{}, err
+	}
+
+	// Data
+	var wg errgroup.Group
+	details := api.MinipoolFinaliseDetails{
+		Address:   minipoolAddress,
+		NodeShare: big.NewInt(0),
+	}
+
+	// Load data
+	wg.Go(func() error {
+		var err error
+		details.MinipoolStatus, err = mp.GetStatus(opts)
+		return err
+	})
+	wg.Go(func() error {
+		var err error
+		details.Finalised, err = mp.GetFinalised(opts)
+		return err
+	})
+	wg.Go(func() error {
+		var blockNumber *big.Int
+		if opts != nil {
+			blockNumber = opts.BlockNumber
+		}
+		var err error
+		details.Balance, err = rp.Client.BalanceAt(context.Background(), minipoolAddress, blockNumber)
+		return err
+	})
+
+	// Wait for data
+	if err := wg.Wait(); err != nil {
+		return api.MinipoolFinaliseDetails{}, err
+	}
+
+	// Check the validator's status on the Beacon Chain
+	details.ValidatorExited = (validator.Exists && validator.ExitEpoch <= currentEpoch)
+	details.ValidatorWithdrawable = (validator.Exists && validator.WithdrawableEpoch <= currentEpoch)
+
+	// Get the node's share of the final balance
+	if !details.Finalised && details.Balance.Cmp(big.NewInt(0)) > 0 {
+		details.NodeShare, err = mp.CalculateNodeShare(details.Balance, opts)
+		if err != nil {
+			return api.MinipoolFinaliseDetails{}, err
+		}
+	}
+
+	// Update & return
+	details.InvalidStatus = (details.MinipoolStatus != types.Withdrawable)
+	details.ValidatorNotExited = !details.ValidatorExited
+	details.CanFinalise = !(details.Finalised || details.InvalidStatus || details.ValidatorNotExited)
+	return details, nil
+
+}
@@ -0,0 +1,98 @@
+package minipool
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/rocketpool-go/minipool"
+	"github.com/urfave/cli"
+	eth2types "github.com/wealdtech/go-eth2-types/v2"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+	walletutils "github.com/rocket-pool/smartnode/shared/utils/wallet"
+)
+
+func importKey(c *cli.Context, minipoolAddress common.Address, keystorePath string, passwordPath string) (*api.ImportMinipoolKeyResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeRegistered(c); err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.ImportMinipoolKeyResponse{}
+
+	// Create minipool
+	mp, err := minipool.NewMinipool(rp, minipoolAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate minipool owner
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+	if err := validateMinipoolOwner(mp, nodeAccount.Address); err != nil {
+		return nil, err
+	}
+
+	// Get the minipool's validator pubkey
+	validatorPubkey, err := minipool.GetMinipoolPubkey(rp, mp.Address, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Read the keystore and its password
+	keystoreJson, err := ioutil.ReadFile(keystorePath)
+	if err != nil {
+		return nil, fmt.Errorf("error reading keystore from %s: %w", keystorePath, err)
+	}
+	passwordBytes, err := ioutil.ReadFile(passwordPath)
+	if err != nil {
+		return nil, fmt.Errorf("error reading keystore password from %s: %w", passwordPath, err)
+	}
+	password := string(passwordBytes)
+
+	// Deserialize the keystore
+	keystore := api.ValidatorKeystore{}
+	if err := json.Unmarshal(keystoreJson, &keystore); err != nil {
+		return nil, fmt.Errorf("error deserializing keystore: %w", err)
+	}
+	if keystore.Pubkey != validatorPubkey {
+		return nil, fmt.Errorf("keystore is for validator %s but minipool %s uses validator %s", keystore.Pubkey.Hex(), mp.Address.Hex(), validatorPubkey.Hex())
+	}
+
+	// Decrypt the private key
+	if err := eth2types.InitBLS(); err != nil {
+		return nil, fmt.Errorf("error initializing BLS: %w", err)
+	}
+	privateKey, err := walletutils.DecryptValidatorKeystore(keystore, password)
+	if err != nil {
+		return nil, err
+	}
+
+	// Store the key
+	if err := w.StoreValidatorKey(privateKey, keystore.Path); err != nil {
+		return nil, fmt.Errorf("error storing validator key for %s: %w", validatorPubkey.Hex(), err)
+	}
+	if err := w.Save(); err != nil {
+		return nil, err
+	}
+	response.Pubkey = validatorPubkey
+
+	// Return response
+	return &response, nil
+
+}
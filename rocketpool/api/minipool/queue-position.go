@@ -0,0 +1,87 @@
+package minipool
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/rocketpool-go/deposit"
+	"github.com/rocket-pool/rocketpool-go/minipool"
+	"github.com/rocket-pool/rocketpool-go/types"
+	"github.com/urfave/cli"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+func getQueuePosition(c *cli.Context, minipoolAddress common.Address) (*api.GetMinipoolQueuePositionResponse, error) {
+
+	// Get services
+	if err := services.RequireRocketStorage(c); err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.GetMinipoolQueuePositionResponse{}
+
+	// Create minipool
+	mp, err := minipool.NewMinipool(rp, minipoolAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	// Sync
+	var wg errgroup.Group
+
+	// Get the minipool's status
+	wg.Go(func() error {
+		var err error
+		response.MinipoolStatus, err = mp.GetStatus(nil)
+		return err
+	})
+
+	// Get the minipool's queue position
+	wg.Go(func() error {
+		queueDetails, err := minipool.GetQueueDetails(rp, mp, nil)
+		if err == nil {
+			response.Position = int64(queueDetails.Position)
+		}
+		return err
+	})
+
+	// Get minipool queue length
+	wg.Go(func() error {
+		var err error
+		response.QueueLength, err = minipool.GetQueueTotalLength(rp, nil)
+		return err
+	})
+
+	// Get minipool queue capacity
+	wg.Go(func() error {
+		var err error
+		response.QueueCapacity, err = minipool.GetQueueTotalCapacity(rp, nil)
+		return err
+	})
+
+	// Get deposit pool balance
+	wg.Go(func() error {
+		var err error
+		response.DepositPoolBalance, err = deposit.GetBalance(rp, nil)
+		return err
+	})
+
+	// Wait for data
+	if err := wg.Wait(); err != nil {
+		return nil, err
+	}
+
+	// Only minipools waiting for assignment have a queue position
+	response.InvalidStatus = !(response.MinipoolStatus == types.Initialized || response.MinipoolStatus == types.Prelaunch)
+	response.NotQueued = (response.Position <= 0)
+
+	// Return response
+	return &response, nil
+
+}
@@ -35,6 +35,10 @@ func canStakeMinipool(c *cli.Context, minipoolAddress common.Address) (*api.CanS
 	if err != nil {
 		return nil, err
 	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
 
 	// Response
 	response := api.CanStakeMinipoolResponse{
@@ -84,7 +88,7 @@ func canStakeMinipool(c *cli.Context, minipoolAddress common.Address) (*api.CanS
 
 	if response.CanStake {
 		// Get eth2 config
-		eth2Config, err := bc.GetEth2Config()
+		eth2Config, err := validator.GetDepositEth2Config(cfg, bc)
 		if err != nil {
 			return nil, err
 		}
@@ -148,6 +152,10 @@ func stakeMinipool(c *cli.Context, minipoolAddress common.Address) (*api.StakeMi
 	if err != nil {
 		return nil, err
 	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
 
 	// Response
 	response := api.StakeMinipoolResponse{}
@@ -171,7 +179,7 @@ func stakeMinipool(c *cli.Context, minipoolAddress common.Address) (*api.StakeMi
 	}
 
 	// Get eth2 config
-	eth2Config, err := bc.GetEth2Config()
+	eth2Config, err := validator.GetDepositEth2Config(cfg, bc)
 	if err != nil {
 		return nil, err
 	}
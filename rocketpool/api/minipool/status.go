@@ -39,19 +39,28 @@ func getStatus(c *cli.Context) (*api.MinipoolStatusResponse, error) {
 	if err != nil {
 		return nil, err
 	}
-	details, err := getNodeMinipoolDetails(rp, bc, nodeAccount.Address)
+	opts, statusOpts, err := services.GetQueryOpts(c)
+	if err != nil {
+		return nil, err
+	}
+	details, err := getNodeMinipoolDetails(rp, bc, nodeAccount.Address, opts, statusOpts)
 	if err != nil {
 		return nil, err
 	}
-	response.Minipools = details
-
 	delegate, err := rp.GetContract("rocketMinipoolDelegate")
 	if err != nil {
 		return nil, fmt.Errorf("Error getting latest minipool delegate contract: %w", err)
 	}
-
 	response.LatestDelegate = *delegate.Address
 
+	for i := range details {
+		if details[i].Validator.Slashed {
+			response.HasSlashedValidators = true
+		}
+		details[i].NeedsUpgrade = (details[i].EffectiveDelegate != response.LatestDelegate)
+	}
+	response.Minipools = details
+
 	// Return response
 	return &response, nil
 
@@ -7,6 +7,7 @@ import (
 	"math/big"
 	"time"
 
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/rocket-pool/rocketpool-go/minipool"
 	"github.com/rocket-pool/rocketpool-go/rocketpool"
@@ -39,7 +40,13 @@ func validateMinipoolOwner(mp *minipool.Minipool, nodeAddress common.Address) er
 }
 
 // Get all node minipool details
-func getNodeMinipoolDetails(rp *rocketpool.RocketPool, bc beacon.Client, nodeAddress common.Address) ([]api.MinipoolDetails, error) {
+func getNodeMinipoolDetails(rp *rocketpool.RocketPool, bc beacon.Client, nodeAddress common.Address, opts *bind.CallOpts, statusOpts *beacon.ValidatorStatusOptions) ([]api.MinipoolDetails, error) {
+
+	// Get the block to query headers at
+	var blockNumber *big.Int
+	if opts != nil {
+		blockNumber = opts.BlockNumber
+	}
 
 	// Data
 	var wg1 errgroup.Group
@@ -51,7 +58,7 @@ func getNodeMinipoolDetails(rp *rocketpool.RocketPool, bc beacon.Client, nodeAdd
 	// Get minipool addresses
 	wg1.Go(func() error {
 		var err error
-		addresses, err = minipool.GetNodeMinipoolAddresses(rp, nodeAddress, nil)
+		addresses, err = minipool.GetNodeMinipoolAddresses(rp, nodeAddress, opts)
 		return err
 	})
 
@@ -63,17 +70,19 @@ func getNodeMinipoolDetails(rp *rocketpool.RocketPool, bc beacon.Client, nodeAdd
 	})
 
 	// Get current epoch
-	wg1.Go(func() error {
-		head, err := bc.GetBeaconHead()
-		if err == nil {
-			currentEpoch = head.Epoch
-		}
-		return err
-	})
+	if statusOpts == nil {
+		wg1.Go(func() error {
+			head, err := bc.GetBeaconHead()
+			if err == nil {
+				currentEpoch = head.Epoch
+			}
+			return err
+		})
+	}
 
 	// Get current block
 	wg1.Go(func() error {
-		header, err := rp.Client.HeaderByNumber(context.Background(), nil)
+		header, err := rp.Client.HeaderByNumber(context.Background(), blockNumber)
 		if err == nil {
 			currentBlock = header.Number.Uint64()
 		}
@@ -84,9 +93,12 @@ func getNodeMinipoolDetails(rp *rocketpool.RocketPool, bc beacon.Client, nodeAdd
 	if err := wg1.Wait(); err != nil {
 		return []api.MinipoolDetails{}, err
 	}
+	if statusOpts != nil {
+		currentEpoch = *statusOpts.Slot / eth2Config.SlotsPerEpoch
+	}
 
 	// Get minipool validator statuses
-	validators, err := rputils.GetMinipoolValidators(rp, bc, addresses, nil, nil)
+	validators, err := rputils.GetMinipoolValidators(rp, bc, addresses, opts, statusOpts)
 	if err != nil {
 		return []api.MinipoolDetails{}, err
 	}
@@ -109,7 +121,7 @@ func getNodeMinipoolDetails(rp *rocketpool.RocketPool, bc beacon.Client, nodeAdd
 			wg.Go(func() error {
 				address := addresses[mi]
 				validator := validators[address]
-				mpDetails, err := getMinipoolDetails(rp, address, validator, eth2Config, currentEpoch, currentBlock)
+				mpDetails, err := getMinipoolDetails(rp, address, validator, eth2Config, currentEpoch, currentBlock, opts)
 				if err == nil {
 					details[mi] = mpDetails
 				}
@@ -123,20 +135,26 @@ func getNodeMinipoolDetails(rp *rocketpool.RocketPool, bc beacon.Client, nodeAdd
 	}
 
 	// Get the scrub period
-	scrubPeriodSeconds, err := trustednode.GetScrubPeriod(rp, nil)
+	scrubPeriodSeconds, err := trustednode.GetScrubPeriod(rp, opts)
 	if err != nil {
 		return nil, err
 	}
 	scrubPeriod := time.Duration(scrubPeriodSeconds) * time.Second
 
 	// Get the dissolve timeout
-	timeout, err := protocol.GetMinipoolLaunchTimeout(rp, nil)
+	timeout, err := protocol.GetMinipoolLaunchTimeout(rp, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the number of votes required to scrub a minipool
+	scrubThreshold, err := rputils.GetScrubVoteThreshold(rp, opts)
 	if err != nil {
 		return nil, err
 	}
 
 	// Get the time of the latest block
-	latestEth1Block, err := rp.Client.HeaderByNumber(context.Background(), nil)
+	latestEth1Block, err := rp.Client.HeaderByNumber(context.Background(), blockNumber)
 	if err != nil {
 		return nil, fmt.Errorf("Can't get the latest block time: %w", err)
 	}
@@ -145,12 +163,16 @@ func getNodeMinipoolDetails(rp *rocketpool.RocketPool, bc beacon.Client, nodeAdd
 	// Check the stake status of each minipool
 	for i, mpDetails := range details {
 		if mpDetails.Status.Status == types.Prelaunch {
+			details[i].ScrubThreshold = scrubThreshold
 			creationTime := mpDetails.Status.StatusTime
 			dissolveTime := creationTime.Add(timeout)
 			remainingTime := creationTime.Add(scrubPeriod).Sub(latestBlockTime)
 			if remainingTime < 0 {
 				details[i].CanStake = true
 				details[i].TimeUntilDissolve = time.Until(dissolveTime)
+			} else {
+				details[i].InScrubPeriod = true
+				details[i].TimeUntilScrubEnd = remainingTime
 			}
 		}
 	}
@@ -161,7 +183,7 @@ func getNodeMinipoolDetails(rp *rocketpool.RocketPool, bc beacon.Client, nodeAdd
 }
 
 // Get a minipool's details
-func getMinipoolDetails(rp *rocketpool.RocketPool, minipoolAddress common.Address, validator beacon.ValidatorStatus, eth2Config beacon.Eth2Config, currentEpoch, currentBlock uint64) (api.MinipoolDetails, error) {
+func getMinipoolDetails(rp *rocketpool.RocketPool, minipoolAddress common.Address, validator beacon.ValidatorStatus, eth2Config beacon.Eth2Config, currentEpoch, currentBlock uint64, opts *bind.CallOpts) (api.MinipoolDetails, error) {
 
 	// Create minipool
 	mp, err := minipool.NewMinipool(rp, minipoolAddress)
@@ -176,67 +198,67 @@ func getMinipoolDetails(rp *rocketpool.RocketPool, minipoolAddress common.Addres
 	// Load data
 	wg.Go(func() error {
 		var err error
-		details.ValidatorPubkey, err = minipool.GetMinipoolPubkey(rp, minipoolAddress, nil)
+		details.ValidatorPubkey, err = minipool.GetMinipoolPubkey(rp, minipoolAddress, opts)
 		return err
 	})
 	wg.Go(func() error {
 		var err error
-		details.Status, err = mp.GetStatusDetails(nil)
+		details.Status, err = mp.GetStatusDetails(opts)
 		return err
 	})
 	wg.Go(func() error {
 		var err error
-		details.DepositType, err = mp.GetDepositType(nil)
+		details.DepositType, err = mp.GetDepositType(opts)
 		return err
 	})
 	wg.Go(func() error {
 		var err error
-		details.Node, err = mp.GetNodeDetails(nil)
+		details.Node, err = mp.GetNodeDetails(opts)
 		return err
 	})
 	wg.Go(func() error {
 		var err error
-		details.User, err = mp.GetUserDetails(nil)
+		details.User, err = mp.GetUserDetails(opts)
 		return err
 	})
 	wg.Go(func() error {
 		var err error
-		details.Balances, err = tokens.GetBalances(rp, minipoolAddress, nil)
+		details.Balances, err = tokens.GetBalances(rp, minipoolAddress, opts)
 		return err
 	})
 	wg.Go(func() error {
 		var err error
-		details.UseLatestDelegate, err = mp.GetUseLatestDelegate(nil)
+		details.UseLatestDelegate, err = mp.GetUseLatestDelegate(opts)
 		return err
 	})
 	wg.Go(func() error {
 		var err error
-		details.Delegate, err = mp.GetDelegate(nil)
+		details.Delegate, err = mp.GetDelegate(opts)
 		return err
 	})
 	wg.Go(func() error {
 		var err error
-		details.PreviousDelegate, err = mp.GetPreviousDelegate(nil)
+		details.PreviousDelegate, err = mp.GetPreviousDelegate(opts)
 		return err
 	})
 	wg.Go(func() error {
 		var err error
-		details.EffectiveDelegate, err = mp.GetEffectiveDelegate(nil)
+		details.EffectiveDelegate, err = mp.GetEffectiveDelegate(opts)
 		return err
 	})
 	wg.Go(func() error {
 		var err error
-		details.Finalised, err = mp.GetFinalised(nil)
+		details.Finalised, err = mp.GetFinalised(opts)
 		return err
 	})
 	wg.Go(func() error {
 		var err error
-		details.Penalties, err = minipool.GetMinipoolPenaltyCount(rp, minipoolAddress, nil)
+		details.Penalties, err = minipool.GetMinipoolPenaltyCount(rp, minipoolAddress, opts)
 		return err
 	})
 	wg.Go(func() error {
 		var err error
-		details.Queue, err = minipool.GetQueueDetails(rp, mp, nil)
+		details.Queue, err = minipool.GetQueueDetails(rp, mp, opts)
 		return err
 	})
 
@@ -245,6 +267,14 @@ func getMinipoolDetails(rp *rocketpool.RocketPool, minipoolAddress common.Addres
 		return api.MinipoolDetails{}, err
 	}
 
+	// Get the oDAO scrub votes if prelaunch
+	if details.Status.Status == types.Prelaunch {
+		details.ScrubVotes, err = rputils.GetMinipoolTotalScrubVotes(mp, opts)
+		if err != nil {
+			return api.MinipoolDetails{}, err
+		}
+	}
+
 	// Get validator details if staking
 	if details.Status.Status == types.Staking {
 		validatorDetails, err := getMinipoolValidatorDetails(rp, details, validator, eth2Config, currentEpoch)
@@ -254,7 +284,11 @@ func getMinipoolDetails(rp *rocketpool.RocketPool, minipoolAddress common.Addres
 		details.Validator = validatorDetails
 	}
 
+	// A slashed validator may have already exited, so check it regardless of the minipool's status
+	details.Validator.Slashed = (validator.Exists && validator.Slashed)
+
 	// Update & return
+	details.PenaltyThresholdMet = (details.Penalties >= rputils.MinipoolPenaltyThreshold)
 	details.RefundAvailable = (details.Node.RefundBalance.Cmp(big.NewInt(0)) > 0)
 	details.CloseAvailable = (details.Status.Status == types.Dissolved)
 	if details.Status.Status == types.Withdrawable {
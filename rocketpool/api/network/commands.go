@@ -53,6 +53,46 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 				},
 			},
 
+			{
+				Name:      "effective-rpl-at-block",
+				Usage:     "Get the network's total effective RPL stake and the RPL price at a past block",
+				UsageText: "rocketpool api network effective-rpl-at-block block-number",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+					blockNumber, err := cliutils.ValidateUint("block number", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(getEffectiveRplAtBlock(c, blockNumber))
+					return nil
+
+				},
+			},
+
+			{
+				Name:      "oracle-rpl-price",
+				Usage:     "Get the current RPL price in ETH from the price oracle the Oracle DAO submits from",
+				UsageText: "rocketpool api network oracle-rpl-price",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(getOracleRplPrice(c))
+					return nil
+
+				},
+			},
+
 			{
 				Name:      "stats",
 				Aliases:   []string{"s"},
@@ -118,6 +158,12 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 				Name:      "generate-rewards-tree",
 				Usage:     "Set a request marker for the watchtower to generate the rewards tree for the given interval",
 				UsageText: "rocketpool api network generate-rewards-tree index",
+				Flags: []cli.Flag{
+					cli.BoolFlag{
+						Name:  "dry-run",
+						Usage: "Generate the tree into a separate folder without replacing the interval's rewards files",
+					},
+				},
 				Action: func(c *cli.Context) error {
 
 					// Validate args
@@ -131,7 +177,29 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(generateRewardsTree(c, index))
+					api.PrintResponse(generateRewardsTree(c, index, c.Bool("dry-run")))
+					return nil
+
+				},
+			},
+
+			{
+				Name:      "download-rewards-files",
+				Usage:     "Download the rewards tree file for every completed interval from the given one onward, skipping files already on disk",
+				UsageText: "rocketpool api network download-rewards-files start-interval",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+					startInterval, err := cliutils.ValidateUint("start interval", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(downloadRewardsFiles(c, startInterval))
 					return nil
 
 				},
@@ -0,0 +1,66 @@
+package network
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/rocketpool-go/rewards"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	rprewards "github.com/rocket-pool/smartnode/shared/services/rewards"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+func downloadRewardsFiles(c *cli.Context, startInterval uint64) (*api.DownloadRewardsFilesResponse, error) {
+
+	// Get services
+	if err := services.RequireRocketStorage(c); err != nil {
+		return nil, err
+	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.DownloadRewardsFilesResponse{
+		Downloaded: []uint64{},
+		Skipped:    []uint64{},
+	}
+
+	// Get the current interval; every interval before it has a published tree
+	currentIndexBig, err := rewards.GetRewardIndex(rp, nil)
+	if err != nil {
+		return nil, err
+	}
+	currentIndex := currentIndexBig.Uint64()
+	if startInterval >= currentIndex {
+		return nil, fmt.Errorf("Interval %d hasn't finished yet; the latest completed interval is %d.", startInterval, int64(currentIndex)-1)
+	}
+
+	// Download each missing file, skipping the ones that are already on disk
+	for interval := startInterval; interval < currentIndex; interval++ {
+		intervalInfo, err := rprewards.GetIntervalInfo(rp, cfg, common.Address{}, interval)
+		if err != nil {
+			return nil, err
+		}
+		if intervalInfo.TreeFileExists {
+			response.Skipped = append(response.Skipped, interval)
+			continue
+		}
+		err = rprewards.DownloadRewardsFile(cfg, interval, intervalInfo.CID, true)
+		if err != nil {
+			return nil, fmt.Errorf("Error downloading the rewards file for interval %d: %w", interval, err)
+		}
+		response.Downloaded = append(response.Downloaded, interval)
+	}
+
+	// Return response
+	return &response, nil
+
+}
@@ -0,0 +1,79 @@
+package network
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/rocket-pool/rocketpool-go/network"
+	"github.com/rocket-pool/rocketpool-go/node"
+	"github.com/rocket-pool/rocketpool-go/utils/eth"
+	"github.com/urfave/cli"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+	"github.com/rocket-pool/smartnode/shared/utils/eth1"
+)
+
+func getEffectiveRplAtBlock(c *cli.Context, blockNumber uint64) (*api.EffectiveRplAtBlockResponse, error) {
+
+	// Get services
+	if err := services.RequireRocketStorage(c); err != nil {
+		return nil, err
+	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.EffectiveRplAtBlockResponse{
+		BlockNumber: blockNumber,
+	}
+	opts := &bind.CallOpts{
+		BlockNumber: big.NewInt(0).SetUint64(blockNumber),
+	}
+
+	// Older blocks may need an archive EC; messages are dropped since they'd break the API's JSON output
+	client, err := eth1.GetBestApiClient(rp, cfg, func(string) {}, opts.BlockNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	// Sync
+	var wg errgroup.Group
+
+	// Get the total effective RPL stake
+	wg.Go(func() error {
+		var err error
+		response.EffectiveRplStake, err = node.GetTotalEffectiveRPLStake(client, opts)
+		return err
+	})
+
+	// Get the RPL price
+	wg.Go(func() error {
+		var err error
+		response.RplPrice, err = network.GetRPLPrice(client, opts)
+		return err
+	})
+
+	// Wait for data
+	if err := wg.Wait(); err != nil {
+		return nil, err
+	}
+
+	// Get the ETH value of the stake (the RPL price is in ETH per RPL, with 18 decimals)
+	response.EffectiveRplStakeValue = big.NewInt(0).Mul(response.EffectiveRplStake, response.RplPrice)
+	response.EffectiveRplStakeValue.Quo(response.EffectiveRplStakeValue, eth.EthToWei(1))
+
+	// Update & return response
+	response.EffectiveRplStakeRpl = eth.WeiToEth(response.EffectiveRplStake)
+	response.EffectiveRplStakeEth = eth.WeiToEth(response.EffectiveRplStakeValue)
+	response.RplPriceEth = eth.WeiToEth(response.RplPrice)
+	return &response, nil
+
+}
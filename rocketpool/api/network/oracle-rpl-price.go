@@ -0,0 +1,55 @@
+package network
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+	rputils "github.com/rocket-pool/smartnode/shared/utils/rp"
+)
+
+func getOracleRplPrice(c *cli.Context) (*api.OracleRplPriceResponse, error) {
+
+	// Get services
+	if err := services.RequireEthClientSynced(c); err != nil {
+		return nil, err
+	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	ec, err := services.GetEthClient(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.OracleRplPriceResponse{}
+
+	// Pin the query to the latest block so the price and block number match
+	blockNumber, err := ec.BlockNumber(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	opts := &bind.CallOpts{
+		BlockNumber: new(big.Int).SetUint64(blockNumber),
+	}
+
+	// Get the price
+	response.RplPrice, err = rputils.GetOracleRplPrice(cfg, ec, opts)
+	if err != nil {
+		return nil, err
+	}
+	response.BlockNumber = blockNumber
+	response.OracleAddress = common.HexToAddress(cfg.Smartnode.GetOneInchOracleAddress())
+	response.RplTokenAddress = common.HexToAddress(cfg.Smartnode.GetRplTokenAddress())
+
+	// Return response
+	return &response, nil
+
+}
@@ -174,7 +174,7 @@ func getStats(c *cli.Context) (*api.NetworkStatsResponse, error) {
 		response.StakingMinipoolCount +
 		response.WithdrawableMinipoolCount +
 		response.DissolvedMinipoolCount
-	tvl := float64(activeMinipools)*32 + response.DepositPoolBalance + response.MinipoolCapacity + (response.TotalRplStaked * response.RplPrice)
+	tvl := float64(activeMinipools)*32 + response.DepositPoolBalance + response.MinipoolCapacity + response.SmoothingPoolBalance + (response.TotalRplStaked * response.RplPrice)
 	response.TotalValueLocked = tvl
 
 	// Return response
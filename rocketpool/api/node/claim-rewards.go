@@ -51,8 +51,14 @@ func getRewardsInfo(c *cli.Context) (*api.NodeGetRewardsInfoResponse, error) {
 		return nil, err
 	}
 
+	// Get the call options for the queries
+	opts, err := services.GetCallOpts(c)
+	if err != nil {
+		return nil, err
+	}
+
 	// Get the claimed and unclaimed intervals
-	unclaimed, claimed, err := rprewards.GetClaimStatus(rp, nodeAccount.Address)
+	unclaimed, claimed, err := rprewards.GetClaimStatus(rp, nodeAccount.Address, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -76,7 +82,7 @@ func getRewardsInfo(c *cli.Context) (*api.NodeGetRewardsInfoResponse, error) {
 	// Get collateral info for restaking
 	var totalMinipools int
 	var finalizedMinipools int
-	details, err := getNodeMinipoolCountDetails(rp, nodeAccount.Address)
+	details, err := getNodeMinipoolCountDetails(rp, nodeAccount.Address, opts)
 	if err == nil {
 		totalMinipools = len(details)
 		for _, mpDetails := range details {
@@ -85,11 +91,11 @@ func getRewardsInfo(c *cli.Context) (*api.NodeGetRewardsInfoResponse, error) {
 			}
 		}
 	}
-	response.RplStake, err = node.GetNodeRPLStake(rp, nodeAccount.Address, nil)
+	response.RplStake, err = node.GetNodeRPLStake(rp, nodeAccount.Address, opts)
 	if err != nil {
 		return nil, err
 	}
-	response.RplPrice, err = network.GetRPLPrice(rp, nil)
+	response.RplPrice, err = network.GetRPLPrice(rp, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,115 @@
+package node
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/rocket-pool/rocketpool-go/minipool"
+	"github.com/rocket-pool/rocketpool-go/network"
+	"github.com/rocket-pool/rocketpool-go/node"
+	"github.com/rocket-pool/rocketpool-go/utils/eth"
+	"github.com/urfave/cli"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+	"github.com/rocket-pool/smartnode/shared/utils/eth1"
+)
+
+func getCollateralHistory(c *cli.Context, blockNumbers []uint64) (*api.NodeGetCollateralHistoryResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeRegistered(c); err != nil {
+		return nil, err
+	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.NodeGetCollateralHistoryResponse{
+		History: make([]api.NodeCollateralSnapshot, len(blockNumbers)),
+	}
+
+	// Get node account
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+
+	for i, blockNumber := range blockNumbers {
+		snapshot := &response.History[i]
+		snapshot.BlockNumber = blockNumber
+		opts := &bind.CallOpts{
+			BlockNumber: big.NewInt(0).SetUint64(blockNumber),
+		}
+
+		// Older blocks may need an archive EC; messages are dropped since they'd break the API's JSON output
+		client, err := eth1.GetBestApiClient(rp, cfg, func(string) {}, opts.BlockNumber)
+		if err != nil {
+			return nil, err
+		}
+
+		// Sync
+		var wg errgroup.Group
+		var activeMinipools uint64
+		var rplPrice *big.Int
+
+		// Get the node's RPL stake
+		wg.Go(func() error {
+			var err error
+			snapshot.RplStake, err = node.GetNodeRPLStake(client, nodeAccount.Address, opts)
+			return err
+		})
+
+		// Get the node's minimum RPL stake
+		wg.Go(func() error {
+			var err error
+			snapshot.MinimumRplStake, err = node.GetNodeMinimumRPLStake(client, nodeAccount.Address, opts)
+			return err
+		})
+
+		// Get the number of active minipools
+		wg.Go(func() error {
+			var err error
+			activeMinipools, err = minipool.GetNodeActiveMinipoolCount(client, nodeAccount.Address, opts)
+			return err
+		})
+
+		// Get the RPL price
+		wg.Go(func() error {
+			var err error
+			rplPrice, err = network.GetRPLPrice(client, opts)
+			return err
+		})
+
+		// Wait for data
+		if err := wg.Wait(); err != nil {
+			return nil, fmt.Errorf("error getting collateral for block %d: %w", blockNumber, err)
+		}
+
+		// Get the collateral ratio, the same way node status does
+		snapshot.ActiveMinipools = activeMinipools
+		snapshot.RplPrice = rplPrice
+		if activeMinipools > 0 {
+			snapshot.CollateralRatio = eth.WeiToEth(rplPrice) * eth.WeiToEth(snapshot.RplStake) / (float64(activeMinipools) * 16.0)
+		} else {
+			snapshot.CollateralRatio = -1
+		}
+		snapshot.Undercollateralized = (snapshot.RplStake.Cmp(snapshot.MinimumRplStake) < 0)
+	}
+
+	// Return response
+	return &response, nil
+
+}
@@ -1,6 +1,10 @@
 package node
 
 import (
+	"fmt"
+	"math/big"
+	"strings"
+
 	"github.com/urfave/cli"
 
 	"github.com/rocket-pool/smartnode/shared/utils/api"
@@ -34,6 +38,58 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 				},
 			},
 
+			{
+				Name:      "get-collateral-history",
+				Usage:     "Get the node's RPL collateral ratio at each of the provided blocks",
+				UsageText: "rocketpool api node get-collateral-history block-numbers",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+					blockNumbers := []uint64{}
+					for _, blockNumberString := range strings.Split(c.Args().Get(0), ",") {
+						blockNumber, err := cliutils.ValidateUint("block number", blockNumberString)
+						if err != nil {
+							return err
+						}
+						blockNumbers = append(blockNumbers, blockNumber)
+					}
+
+					// Run
+					api.PrintResponse(getCollateralHistory(c, blockNumbers))
+					return nil
+
+				},
+			},
+
+			{
+				Name:      "get-rpl-stake-limits",
+				Usage:     "Get the node's minimum and maximum RPL stake, and how much RPL it needs to stake or can withdraw to reach each of the provided collateral percentages",
+				UsageText: "rocketpool api node get-rpl-stake-limits target-percents",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+					targetPercents := []float64{}
+					for _, targetPercentString := range strings.Split(c.Args().Get(0), ",") {
+						targetPercent, err := cliutils.ValidatePercentage("target collateral percent", targetPercentString)
+						if err != nil {
+							return err
+						}
+						targetPercents = append(targetPercents, targetPercent)
+					}
+
+					// Run
+					api.PrintResponse(getRplStakeLimits(c, targetPercents))
+					return nil
+
+				},
+			},
+
 			{
 				Name:      "sync",
 				Aliases:   []string{"y"},
@@ -596,7 +652,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 
 			{
 				Name:      "can-send",
-				Usage:     "Check whether the node can send ETH or tokens to an address",
+				Usage:     "Check whether the node can send ETH or tokens to an address; use an amount of 'max' to send the node's full balance",
 				UsageText: "rocketpool api node can-send amount token",
 				Action: func(c *cli.Context) error {
 
@@ -604,9 +660,13 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					if err := cliutils.ValidateArgCount(c, 2); err != nil {
 						return err
 					}
-					amountWei, err := cliutils.ValidatePositiveWeiAmount("send amount", c.Args().Get(0))
-					if err != nil {
-						return err
+					var amountWei *big.Int
+					if c.Args().Get(0) != "max" {
+						var err error
+						amountWei, err = cliutils.ValidatePositiveWeiAmount("send amount", c.Args().Get(0))
+						if err != nil {
+							return err
+						}
 					}
 					token, err := cliutils.ValidateTokenType("token type", c.Args().Get(1))
 					if err != nil {
@@ -622,7 +682,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 			{
 				Name:      "send",
 				Aliases:   []string{"n"},
-				Usage:     "Send ETH or tokens from the node account to an address",
+				Usage:     "Send ETH or tokens from the node account to an address; use an amount of 'max' to send the node's full balance",
 				UsageText: "rocketpool api node send amount token to",
 				Action: func(c *cli.Context) error {
 
@@ -630,9 +690,13 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					if err := cliutils.ValidateArgCount(c, 3); err != nil {
 						return err
 					}
-					amountWei, err := cliutils.ValidatePositiveWeiAmount("send amount", c.Args().Get(0))
-					if err != nil {
-						return err
+					var amountWei *big.Int
+					if c.Args().Get(0) != "max" {
+						var err error
+						amountWei, err = cliutils.ValidatePositiveWeiAmount("send amount", c.Args().Get(0))
+						if err != nil {
+							return err
+						}
 					}
 					token, err := cliutils.ValidateTokenType("token type", c.Args().Get(1))
 					if err != nil {
@@ -650,6 +714,40 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 				},
 			},
 
+			{
+				Name:      "validate-deposit-data",
+				Usage:     "Check that a signed deposit is valid for the current network's deposit domain, and get its deposit data root",
+				UsageText: "rocketpool api node validate-deposit-data pubkey withdrawal-credentials amount-gwei signature",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 4); err != nil {
+						return err
+					}
+					pubkey, err := cliutils.ValidatePubkey("pubkey", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+					withdrawalCredentials, err := cliutils.ValidateTxHash("withdrawal credentials", c.Args().Get(1))
+					if err != nil {
+						return err
+					}
+					amountGwei, err := cliutils.ValidatePositiveUint("deposit amount", c.Args().Get(2))
+					if err != nil {
+						return err
+					}
+					signature, err := cliutils.ValidateValidatorSignature("signature", c.Args().Get(3))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(validateDepositData(c, pubkey, withdrawalCredentials, amountGwei, signature))
+					return nil
+
+				},
+			},
+
 			{
 				Name:      "can-burn",
 				Usage:     "Check whether the node can burn tokens for ETH",
@@ -755,6 +853,24 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 				},
 			},
 
+			{
+				Name:      "estimate-rewards",
+				Usage:     "Estimate the node's provisional rewards for the current interval as of the latest finalized block",
+				UsageText: "rocketpool api node estimate-rewards",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(estimateRewards(c))
+					return nil
+
+				},
+			},
+
 			{
 				Name:      "deposit-contract-info",
 				Usage:     "Get information about the deposit contract specified by Rocket Pool and the Beacon Chain client",
@@ -813,6 +929,52 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 				},
 			},
 
+			{
+				Name:      "sign-messages",
+				Usage:     fmt.Sprintf("Signs a batch of up to %d arbitrary messages with the node's private key, returning the signatures in order.", MaxSignMessagesBatchSize),
+				UsageText: "rocketpool api node sign-messages 'message' ['message'...]",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					messages := []string(c.Args())
+					if len(messages) == 0 {
+						return fmt.Errorf("Incorrect argument count; usage: %s", c.Command.UsageText)
+					}
+					if len(messages) > MaxSignMessagesBatchSize {
+						return fmt.Errorf("Too many messages; at most %d can be signed at once", MaxSignMessagesBatchSize)
+					}
+
+					// Run
+					api.PrintResponse(signMessages(c, messages))
+					return nil
+
+				},
+			},
+
+			{
+				Name:      "verify-signed-message",
+				Usage:     "Verifies that a message was signed by the given address, including smart contract wallets via EIP-1271.",
+				UsageText: "rocketpool api node verify-signed-message signature signer 'message'",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 3); err != nil {
+						return err
+					}
+					signature := c.Args().Get(0)
+					signer, err := cliutils.ValidateAddress("signer", c.Args().Get(1))
+					if err != nil {
+						return err
+					}
+					message := c.Args().Get(2)
+
+					// Run
+					api.PrintResponse(verifySignedMessage(c, message, signature, signer))
+					return nil
+
+				},
+			},
+
 			{
 				Name:      "estimate-set-snapshot-delegate-gas",
 				Usage:     "Estimate the gas required to set a voting snapshot delegate",
@@ -970,7 +1132,40 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 			{
 				Name:      "can-distribute",
 				Usage:     "Check if distributing ETH from the node's fee distributor is possible",
-				UsageText: "rocketpool api node can-distribute",
+				UsageText: "rocketpool api node can-distribute [options]",
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "reserve-wei",
+						Usage: "The minimum balance (in wei) the node wallet should keep after paying for the distribution",
+					},
+				},
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Validate flags
+					var reserveWei *big.Int
+					if c.String("reserve-wei") != "" {
+						var err error
+						reserveWei, err = cliutils.ValidateWeiAmount("reserve", c.String("reserve-wei"))
+						if err != nil {
+							return err
+						}
+					}
+
+					// Run
+					api.PrintResponse(canDistribute(c, reserveWei))
+					return nil
+
+				},
+			},
+			{
+				Name:      "can-withdraw-eth",
+				Usage:     "Get how much ETH the node can withdraw right now from its fee distributor, minipool refunds, and unclaimed Smoothing Pool rewards",
+				UsageText: "rocketpool api node can-withdraw-eth",
 				Action: func(c *cli.Context) error {
 
 					// Validate args
@@ -979,7 +1174,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canDistribute(c))
+					api.PrintResponse(canWithdrawEth(c))
 					return nil
 
 				},
@@ -8,7 +8,6 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/prysmaticlabs/prysm/v2/beacon-chain/core/signing"
 	tndao "github.com/rocket-pool/rocketpool-go/dao/trustednode"
 	"github.com/rocket-pool/rocketpool-go/minipool"
 	"github.com/rocket-pool/rocketpool-go/network"
@@ -21,10 +20,7 @@ import (
 	"github.com/urfave/cli"
 	"golang.org/x/sync/errgroup"
 
-	prdeposit "github.com/prysmaticlabs/prysm/v2/contracts/deposit"
-	ethpb "github.com/prysmaticlabs/prysm/v2/proto/prysm/v1alpha1"
 	"github.com/rocket-pool/smartnode/shared/services"
-	"github.com/rocket-pool/smartnode/shared/services/beacon"
 	"github.com/rocket-pool/smartnode/shared/types/api"
 	"github.com/rocket-pool/smartnode/shared/utils/eth1"
 	"github.com/rocket-pool/smartnode/shared/utils/validator"
@@ -59,9 +55,13 @@ func canNodeDeposit(c *cli.Context, amountWei *big.Int, minNodeFee float64, salt
 	if err != nil {
 		return nil, err
 	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
 
 	// Get eth2 config
-	eth2Config, err := bc.GetEth2Config()
+	eth2Config, err := validator.GetDepositEth2Config(cfg, bc)
 	if err != nil {
 		return nil, err
 	}
@@ -178,7 +178,7 @@ func canNodeDeposit(c *cli.Context, amountWei *big.Int, minNodeFee float64, salt
 		signature := rptypes.BytesToValidatorSignature(depositData.Signature)
 
 		// Do a final sanity check
-		err = validateDepositInfo(eth2Config, uint64(validator.DepositAmount), pubKey, withdrawalCredentials, signature)
+		err = validator.ValidateDepositInfo(eth2Config, uint64(validator.DepositAmount), pubKey, withdrawalCredentials, signature)
 		if err != nil {
 			return fmt.Errorf("Your deposit failed the validation safety check: %w\n"+
 				"For your safety, this deposit will not be submitted and your ETH will not be staked.\n"+
@@ -277,9 +277,13 @@ func nodeDeposit(c *cli.Context, amountWei *big.Int, minNodeFee float64, salt *b
 	if err != nil {
 		return nil, err
 	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
 
 	// Get eth2 config
-	eth2Config, err := bc.GetEth2Config()
+	eth2Config, err := validator.GetDepositEth2Config(cfg, bc)
 	if err != nil {
 		return nil, err
 	}
@@ -376,7 +380,7 @@ func nodeDeposit(c *cli.Context, amountWei *big.Int, minNodeFee float64, salt *b
 	}
 
 	// Do a final sanity check
-	err = validateDepositInfo(eth2Config, uint64(validator.DepositAmount), pubKey, withdrawalCredentials, signature)
+	err = validator.ValidateDepositInfo(eth2Config, uint64(validator.DepositAmount), pubKey, withdrawalCredentials, signature)
 	if err != nil {
 		return nil, fmt.Errorf("Your deposit failed the validation safety check: %w\n"+
 			"For your safety, this deposit will not be submitted and your ETH will not be staked.\n"+
@@ -424,24 +428,3 @@ func nodeDeposit(c *cli.Context, amountWei *big.Int, minNodeFee float64, salt *b
 	return &response, nil
 
 }
-
-func validateDepositInfo(eth2Config beacon.Eth2Config, depositAmount uint64, pubkey rptypes.ValidatorPubkey, withdrawalCredentials common.Hash, signature rptypes.ValidatorSignature) error {
-
-	// Get the deposit domain based on the eth2 config
-	depositDomain, err := signing.ComputeDomain(eth2types.DomainDeposit, eth2Config.GenesisForkVersion, eth2types.ZeroGenesisValidatorsRoot)
-	if err != nil {
-		return err
-	}
-
-	// Create the deposit struct
-	depositData := new(ethpb.Deposit_Data)
-	depositData.Amount = depositAmount
-	depositData.PublicKey = pubkey.Bytes()
-	depositData.WithdrawalCredentials = withdrawalCredentials.Bytes()
-	depositData.Signature = signature.Bytes()
-
-	// Validate the signature
-	err = prdeposit.VerifyDepositSignature(depositData, depositDomain)
-	return err
-
-}
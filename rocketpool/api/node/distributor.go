@@ -3,8 +3,10 @@ package node
 import (
 	"context"
 	"fmt"
+	"math/big"
 
 	"github.com/rocket-pool/rocketpool-go/node"
+	"github.com/rocket-pool/rocketpool-go/utils/eth"
 	"github.com/urfave/cli"
 	"golang.org/x/sync/errgroup"
 
@@ -136,7 +138,7 @@ func initializeFeeDistributor(c *cli.Context) (*api.NodeInitializeFeeDistributor
 
 }
 
-func canDistribute(c *cli.Context) (*api.NodeCanDistributeResponse, error) {
+func canDistribute(c *cli.Context, reserveWei *big.Int) (*api.NodeCanDistributeResponse, error) {
 	// Get services
 	if err := services.RequireNodeWallet(c); err != nil {
 		return nil, err
@@ -185,6 +187,13 @@ func canDistribute(c *cli.Context) (*api.NodeCanDistributeResponse, error) {
 		return err
 	})
 
+	// Get the node wallet's balance
+	wg.Go(func() error {
+		var err error
+		response.NodeBalance, err = rp.Client.BalanceAt(context.Background(), nodeAccount.Address, nil)
+		return err
+	})
+
 	// Get gas estimates
 	wg.Go(func() error {
 		var err error
@@ -206,6 +215,21 @@ func canDistribute(c *cli.Context) (*api.NodeCanDistributeResponse, error) {
 		return nil, err
 	}
 
+	// Split the balance the same way the distributor does
+	response.NodeShare = getDistributorNodeShare(response.Balance, response.AverageNodeFee)
+	response.UserShare = new(big.Int).Sub(response.Balance, response.NodeShare)
+
+	// Check if paying for the distribution would take the node wallet below its reserve
+	gasPrice, err := rp.Client.SuggestGasPrice(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	response.GasCost = new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(response.GasInfo.SafeGasLimit))
+	if reserveWei != nil {
+		remainingBalance := new(big.Int).Sub(response.NodeBalance, response.GasCost)
+		response.BelowReserve = (remainingBalance.Cmp(reserveWei) < 0)
+	}
+
 	// Return response
 	return &response, nil
 
@@ -264,3 +288,11 @@ func distribute(c *cli.Context) (*api.NodeDistributeResponse, error) {
 	return &response, nil
 
 }
+
+// Get the node's share of a fee distributor balance: half of the balance plus its commission on the other half
+func getDistributorNodeShare(balance *big.Int, averageNodeFee float64) *big.Int {
+	halfBalance := new(big.Int).Div(balance, big.NewInt(2))
+	nodeCommission := new(big.Int).Mul(halfBalance, eth.EthToWei(averageNodeFee))
+	nodeCommission.Div(nodeCommission, eth.EthToWei(1))
+	return new(big.Int).Add(halfBalance, nodeCommission)
+}
@@ -0,0 +1,118 @@
+package node
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/rocket-pool/rocketpool-go/dao/trustednode"
+	"github.com/rocket-pool/rocketpool-go/rewards"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	rprewards "github.com/rocket-pool/smartnode/shared/services/rewards"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+	"github.com/rocket-pool/smartnode/shared/utils/log"
+)
+
+func estimateRewards(c *cli.Context) (*api.NodeEstimateRewardsResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeRegistered(c); err != nil {
+		return nil, err
+	}
+	if err := services.RequireBeaconClientSynced(c); err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.NodeEstimateRewardsResponse{}
+
+	// Get node account
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+
+	// Use the latest finalized block as the snapshot
+	finalizedBlock, exists, err := bc.GetBeaconBlock("finalized")
+	if err != nil {
+		return nil, fmt.Errorf("Error getting the latest finalized Beacon block: %w", err)
+	}
+	if !exists {
+		return nil, fmt.Errorf("The latest finalized Beacon block could not be found")
+	}
+	if !finalizedBlock.HasExecutionPayload {
+		return nil, fmt.Errorf("The latest finalized Beacon block (slot %d) does not have an execution payload", finalizedBlock.Slot)
+	}
+	elBlockHeader, err := rp.Client.HeaderByNumber(context.Background(), big.NewInt(0).SetUint64(finalizedBlock.ExecutionBlockNumber))
+	if err != nil {
+		return nil, fmt.Errorf("Error getting the header for execution block %d: %w", finalizedBlock.ExecutionBlockNumber, err)
+	}
+	response.ConsensusBlock = finalizedBlock.Slot
+	response.ExecutionBlock = finalizedBlock.ExecutionBlockNumber
+	response.SnapshotTime = time.Unix(int64(elBlockHeader.Time), 0)
+
+	// Get the details of the current interval
+	currentIndex, err := rewards.GetRewardIndex(rp, nil)
+	if err != nil {
+		return nil, fmt.Errorf("Error getting the current rewards interval: %w", err)
+	}
+	response.Index = currentIndex.Uint64()
+	response.IntervalStartTime, err = rewards.GetClaimIntervalTimeStart(rp, nil)
+	if err != nil {
+		return nil, fmt.Errorf("Error getting the start time of the current interval: %w", err)
+	}
+	intervalTime, err := rewards.GetClaimIntervalTime(rp, nil)
+	if err != nil {
+		return nil, fmt.Errorf("Error getting the rewards interval time: %w", err)
+	}
+	intervalsPassed := uint64(response.SnapshotTime.Sub(response.IntervalStartTime) / intervalTime)
+
+	// Check if the node is a member of the Oracle DAO
+	response.Trusted, err = trustednode.GetMemberExists(rp, nodeAccount.Address, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Calculate the provisional rewards for the whole network
+	logger := log.NewColorLogger(color.FgHiBlack)
+	rewardsFile := rprewards.NewRewardsFile(logger, "[Estimate]", response.Index, response.IntervalStartTime, response.SnapshotTime, finalizedBlock.Slot, elBlockHeader, intervalsPassed)
+	err = rewardsFile.CalculateProvisionalRewards(rp, cfg, bc)
+	if err != nil {
+		return nil, fmt.Errorf("Error calculating provisional rewards: %w", err)
+	}
+
+	// Get the node's share
+	response.CollateralRpl = big.NewInt(0)
+	response.OracleDaoRpl = big.NewInt(0)
+	response.SmoothingPoolEth = big.NewInt(0)
+	nodeRewards, exists := rewardsFile.NodeRewards[nodeAccount.Address]
+	if exists {
+		response.CollateralRpl.Set(&nodeRewards.CollateralRpl.Int)
+		response.OracleDaoRpl.Set(&nodeRewards.OracleDaoRpl.Int)
+		response.SmoothingPoolEth.Set(&nodeRewards.SmoothingPoolEth.Int)
+	}
+
+	// Return response
+	return &response, nil
+
+}
@@ -1,7 +1,9 @@
 package node
 
 import (
+	"context"
 	"fmt"
+	"math/big"
 
 	"github.com/rocket-pool/rocketpool-go/node"
 	"github.com/rocket-pool/rocketpool-go/settings/protocol"
@@ -34,15 +36,18 @@ func canRegisterNode(c *cli.Context, timezoneLocation string) (*api.CanRegisterN
 	// Response
 	response := api.CanRegisterNodeResponse{}
 
+	// Get node account
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+	response.NodeAddress = nodeAccount.Address
+
 	// Sync
 	var wg errgroup.Group
 
 	// Check node is not already registered
 	wg.Go(func() error {
-		nodeAccount, err := w.GetNodeAccount()
-		if err != nil {
-			return err
-		}
 		exists, err := node.GetNodeExists(rp, nodeAccount.Address, nil)
 		if err != nil {
 			return err
@@ -60,15 +65,11 @@ func canRegisterNode(c *cli.Context, timezoneLocation string) (*api.CanRegisterN
 		return err
 	})
 
-	// Get gas estimate
+	// Get the node's ETH balance
 	wg.Go(func() error {
-		opts, err := w.GetNodeAccountTransactor()
-		if err != nil {
-			return err
-		}
-		gasInfo, err := node.EstimateRegisterNodeGas(rp, timezoneLocation, opts)
+		balance, err := rp.Client.BalanceAt(context.Background(), nodeAccount.Address, nil)
 		if err == nil {
-			response.GasInfo = gasInfo
+			response.NodeBalance = balance
 		}
 		return err
 	})
@@ -78,8 +79,32 @@ func canRegisterNode(c *cli.Context, timezoneLocation string) (*api.CanRegisterN
 		return nil, err
 	}
 
+	// Registration will revert if either check fails, so don't try to estimate its gas
+	if response.AlreadyRegistered || response.RegistrationDisabled {
+		return &response, nil
+	}
+
+	// Get gas estimate
+	opts, err := w.GetNodeAccountTransactor()
+	if err != nil {
+		return nil, err
+	}
+	gasInfo, err := node.EstimateRegisterNodeGas(rp, timezoneLocation, opts)
+	if err != nil {
+		return nil, err
+	}
+	response.GasInfo = gasInfo
+
+	// Check the node can pay for the registration TX; there is no protocol fee beyond gas
+	gasPrice, err := rp.Client.SuggestGasPrice(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	requiredBalance := new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(gasInfo.SafeGasLimit))
+	response.InsufficientBalance = (response.NodeBalance.Cmp(requiredBalance) < 0)
+
 	// Update & return response
-	response.CanRegister = !(response.AlreadyRegistered || response.RegistrationDisabled)
+	response.CanRegister = !(response.AlreadyRegistered || response.RegistrationDisabled || response.InsufficientBalance)
 	return &response, nil
 
 }
@@ -128,7 +128,7 @@ func getRewards(c *cli.Context) (*api.NodeRewardsResponse, error) {
 		ethRewards := big.NewInt(0)
 
 		// Get the claimed and unclaimed intervals
-		unclaimed, claimed, err := rprewards.GetClaimStatus(rp, nodeAccount.Address)
+		unclaimed, claimed, err := rprewards.GetClaimStatus(rp, nodeAccount.Address, nil)
 		if err != nil {
 			return err
 		}
@@ -307,7 +307,7 @@ func getRewards(c *cli.Context) (*api.NodeRewardsResponse, error) {
 			//rplRewards, err := legacyrewards.CalculateLifetimeTrustedNodeRewards(rp, nodeAccount.Address, big.NewInt(int64(eventLogInterval)), nil, &legacyRocketRewardsAddress, &legacyClaimTrustedNodeAddress)
 
 			// Get the claimed and unclaimed intervals
-			unclaimed, claimed, err := rprewards.GetClaimStatus(rp, nodeAccount.Address)
+			unclaimed, claimed, err := rprewards.GetClaimStatus(rp, nodeAccount.Address, nil)
 			if err != nil {
 				return err
 			}
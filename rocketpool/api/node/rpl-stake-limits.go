@@ -0,0 +1,127 @@
+package node
+
+import (
+	"math/big"
+
+	"github.com/rocket-pool/rocketpool-go/minipool"
+	"github.com/rocket-pool/rocketpool-go/network"
+	"github.com/rocket-pool/rocketpool-go/node"
+	"github.com/rocket-pool/rocketpool-go/utils/eth"
+	"github.com/urfave/cli"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+func getRplStakeLimits(c *cli.Context, targetPercents []float64) (*api.NodeGetRplStakeLimitsResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeRegistered(c); err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.NodeGetRplStakeLimitsResponse{
+		Targets: make([]api.NodeRplStakeTarget, len(targetPercents)),
+	}
+
+	// Get node account
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+
+	// Sync
+	var wg errgroup.Group
+
+	// Get the node's RPL stake
+	wg.Go(func() error {
+		var err error
+		response.RplStake, err = node.GetNodeRPLStake(rp, nodeAccount.Address, nil)
+		return err
+	})
+
+	// Get the node's effective RPL stake
+	wg.Go(func() error {
+		var err error
+		response.EffectiveRplStake, err = node.GetNodeEffectiveRPLStake(rp, nodeAccount.Address, nil)
+		return err
+	})
+
+	// Get the node's minimum RPL stake
+	wg.Go(func() error {
+		var err error
+		response.MinimumRplStake, err = node.GetNodeMinimumRPLStake(rp, nodeAccount.Address, nil)
+		return err
+	})
+
+	// Get the node's maximum RPL stake
+	wg.Go(func() error {
+		var err error
+		response.MaximumRplStake, err = node.GetNodeMaximumRPLStake(rp, nodeAccount.Address, nil)
+		return err
+	})
+
+	// Get the number of active minipools
+	wg.Go(func() error {
+		var err error
+		response.ActiveMinipools, err = minipool.GetNodeActiveMinipoolCount(rp, nodeAccount.Address, nil)
+		return err
+	})
+
+	// Get the RPL price
+	wg.Go(func() error {
+		var err error
+		response.RplPrice, err = network.GetRPLPrice(rp, nil)
+		return err
+	})
+
+	// Wait for data
+	if err := wg.Wait(); err != nil {
+		return nil, err
+	}
+
+	// Get the collateral ratio, the same way node status does
+	if response.ActiveMinipools > 0 {
+		response.CollateralRatio = eth.WeiToEth(response.RplPrice) * eth.WeiToEth(response.RplStake) / (float64(response.ActiveMinipools) * 16.0)
+	} else {
+		response.CollateralRatio = -1
+	}
+
+	// Get the stake needed for each target ratio and how far the node is from it
+	for i, targetPercent := range targetPercents {
+		target := &response.Targets[i]
+		target.CollateralPercent = targetPercent
+		target.RplStake = big.NewInt(0)
+		target.RplToStake = big.NewInt(0)
+		target.RplToWithdraw = big.NewInt(0)
+		if response.ActiveMinipools == 0 || response.RplPrice.Sign() == 0 {
+			continue
+		}
+
+		// Target stake = (borrowed ETH * target percent) / RPL price
+		targetEth := eth.EthToWei(float64(response.ActiveMinipools) * 16.0 * targetPercent / 100)
+		target.RplStake.Mul(targetEth, eth.EthToWei(1))
+		target.RplStake.Div(target.RplStake, response.RplPrice)
+
+		delta := big.NewInt(0).Sub(target.RplStake, response.RplStake)
+		if delta.Sign() > 0 {
+			target.RplToStake = delta
+		} else {
+			target.RplToWithdraw = delta.Neg(delta)
+		}
+	}
+
+	// Return response
+	return &response, nil
+
+}
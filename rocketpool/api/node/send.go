@@ -4,13 +4,18 @@ import (
 	"context"
 	"fmt"
 	"math/big"
+	"strings"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/rocketpool-go/rocketpool"
 	"github.com/rocket-pool/rocketpool-go/tokens"
 	"github.com/rocket-pool/rocketpool-go/utils/eth"
 	"github.com/urfave/cli"
 
 	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/contracts"
 	"github.com/rocket-pool/smartnode/shared/types/api"
 	"github.com/rocket-pool/smartnode/shared/utils/eth1"
 )
@@ -49,6 +54,19 @@ func canNodeSend(c *cli.Context, amountWei *big.Int, token string) (*api.CanNode
 		return nil, err
 	}
 
+	// Native and Rocket Pool tokens all use 18 decimals
+	response.TokenDecimals = 18
+	switch token {
+	case "eth":
+		response.TokenSymbol = "ETH"
+	case "rpl":
+		response.TokenSymbol = "RPL"
+	case "fsrpl":
+		response.TokenSymbol = "fsRPL"
+	case "reth":
+		response.TokenSymbol = "rETH"
+	}
+
 	// Handle token type
 	switch token {
 	case "eth":
@@ -58,13 +76,22 @@ func canNodeSend(c *cli.Context, amountWei *big.Int, token string) (*api.CanNode
 		if err != nil {
 			return nil, err
 		}
-		response.InsufficientBalance = (amountWei.Cmp(ethBalanceWei) > 0)
 		gasInfo, err := eth.EstimateSendTransactionGas(ec, nodeAccount.Address, opts)
 		if err != nil {
 			return nil, err
 		}
 		response.GasInfo = gasInfo
 
+		// Send everything except the gas reserve if requested
+		if amountWei == nil {
+			maxFee, err := getEthSendMaxFee(ec, opts)
+			if err != nil {
+				return nil, err
+			}
+			amountWei = getMaxEthSendAmount(ethBalanceWei, gasInfo.SafeGasLimit, maxFee)
+		}
+		response.InsufficientBalance = (amountWei.Sign() == 0 || amountWei.Cmp(ethBalanceWei) > 0)
+
 	case "rpl":
 
 		// Get RocketStorage
@@ -76,7 +103,10 @@ func canNodeSend(c *cli.Context, amountWei *big.Int, token string) (*api.CanNode
 		if err != nil {
 			return nil, err
 		}
-		response.InsufficientBalance = (amountWei.Cmp(rplBalanceWei) > 0)
+		if amountWei == nil {
+			amountWei = rplBalanceWei
+		}
+		response.InsufficientBalance = (amountWei.Sign() == 0 || amountWei.Cmp(rplBalanceWei) > 0)
 		gasInfo, err := tokens.EstimateTransferRPLGas(rp, nodeAccount.Address, amountWei, opts)
 		if err != nil {
 			return nil, err
@@ -94,7 +124,10 @@ func canNodeSend(c *cli.Context, amountWei *big.Int, token string) (*api.CanNode
 		if err != nil {
 			return nil, err
 		}
-		response.InsufficientBalance = (amountWei.Cmp(fixedSupplyRplBalanceWei) > 0)
+		if amountWei == nil {
+			amountWei = fixedSupplyRplBalanceWei
+		}
+		response.InsufficientBalance = (amountWei.Sign() == 0 || amountWei.Cmp(fixedSupplyRplBalanceWei) > 0)
 		gasInfo, err := tokens.EstimateTransferFixedSupplyRPLGas(rp, nodeAccount.Address, amountWei, opts)
 		if err != nil {
 			return nil, err
@@ -112,16 +145,49 @@ func canNodeSend(c *cli.Context, amountWei *big.Int, token string) (*api.CanNode
 		if err != nil {
 			return nil, err
 		}
-		response.InsufficientBalance = (amountWei.Cmp(rethBalanceWei) > 0)
+		if amountWei == nil {
+			amountWei = rethBalanceWei
+		}
+		response.InsufficientBalance = (amountWei.Sign() == 0 || amountWei.Cmp(rethBalanceWei) > 0)
 		gasInfo, err := tokens.EstimateTransferRETHGas(rp, nodeAccount.Address, amountWei, opts)
 		if err != nil {
 			return nil, err
 		}
 		response.GasInfo = gasInfo
 
+	default:
+
+		// Get the token's details
+		tokenContract, err := getErc20Contract(ec, common.HexToAddress(token))
+		if err != nil {
+			return nil, err
+		}
+		response.TokenName, response.TokenSymbol, response.TokenDecimals, err = getErc20Details(tokenContract)
+		if err != nil {
+			return nil, err
+		}
+
+		// Check node token balance
+		tokenBalance, err := getErc20Balance(tokenContract, nodeAccount.Address)
+		if err != nil {
+			return nil, err
+		}
+		if amountWei == nil {
+			amountWei = tokenBalance
+		}
+		response.InsufficientBalance = (amountWei.Sign() == 0 || amountWei.Cmp(tokenBalance) > 0)
+		if !response.InsufficientBalance {
+			gasInfo, err := tokenContract.GetTransactionGasInfo(opts, "transfer", nodeAccount.Address, amountWei)
+			if err != nil {
+				return nil, err
+			}
+			response.GasInfo = gasInfo
+		}
+
 	}
 
 	// Update & return response
+	response.Amount = amountWei
 	response.CanSend = !response.InsufficientBalance
 	return &response, nil
 
@@ -161,6 +227,15 @@ func nodeSend(c *cli.Context, amountWei *big.Int, token string, to common.Addres
 		return nil, fmt.Errorf("Error checking for nonce override: %w", err)
 	}
 
+	// Get the full balance if requested
+	if amountWei == nil {
+		amountWei, err = getMaxNodeSendAmount(c, opts, token)
+		if err != nil {
+			return nil, err
+		}
+	}
+	response.Amount = amountWei
+
 	// Handle token type
 	switch token {
 	case "eth":
@@ -212,9 +287,156 @@ func nodeSend(c *cli.Context, amountWei *big.Int, token string, to common.Addres
 		}
 		response.TxHash = hash
 
+	default:
+
+		// Transfer the ERC20 token
+		tokenContract, err := getErc20Contract(ec, common.HexToAddress(token))
+		if err != nil {
+			return nil, err
+		}
+		hash, err := tokenContract.Transact(opts, "transfer", to, amountWei)
+		if err != nil {
+			return nil, fmt.Errorf("Could not transfer token %s: %w", token, err)
+		}
+		response.TxHash = hash
+
 	}
 
 	// Return response
 	return &response, nil
 
 }
+
+// Get the amount of ETH that can be sent after reserving enough for gas
+func getMaxEthSendAmount(balance *big.Int, gasLimit uint64, maxFee *big.Int) *big.Int {
+	reserve := big.NewInt(0).Mul(big.NewInt(int64(gasLimit)), maxFee)
+	if reserve.Cmp(balance) >= 0 {
+		return big.NewInt(0)
+	}
+	return big.NewInt(0).Sub(balance, reserve)
+}
+
+// Get the max fee to reserve gas for when sending ETH, falling back to the current gas price if one wasn't provided
+func getEthSendMaxFee(ec rocketpool.ExecutionClient, opts *bind.TransactOpts) (*big.Int, error) {
+	if opts.GasFeeCap != nil && opts.GasFeeCap.Sign() > 0 {
+		return opts.GasFeeCap, nil
+	}
+	maxFee, err := ec.SuggestGasPrice(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("Error getting the current gas price: %w", err)
+	}
+	return maxFee, nil
+}
+
+// Get the node's full balance of a token, minus the gas reserve for ETH sends
+func getMaxNodeSendAmount(c *cli.Context, opts *bind.TransactOpts, token string) (*big.Int, error) {
+
+	// Get services
+	ec, err := services.GetEthClient(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Handle token type
+	switch token {
+	case "eth":
+		// Estimate the transfer the same way canNodeSend does if the gas settings weren't provided, and use them for the transfer too
+		maxFee, err := getEthSendMaxFee(ec, opts)
+		if err != nil {
+			return nil, err
+		}
+		opts.GasFeeCap = maxFee
+		if opts.GasLimit == 0 {
+			gasInfo, err := eth.EstimateSendTransactionGas(ec, opts.From, opts)
+			if err != nil {
+				return nil, err
+			}
+			opts.GasLimit = gasInfo.SafeGasLimit
+		}
+		balance, err := ec.BalanceAt(context.Background(), opts.From, nil)
+		if err != nil {
+			return nil, err
+		}
+		amount := getMaxEthSendAmount(balance, opts.GasLimit, opts.GasFeeCap)
+		if amount.Sign() == 0 {
+			return nil, fmt.Errorf("The node's ETH balance is too low to cover the gas cost of the transfer.")
+		}
+		return amount, nil
+	}
+
+	// Arbitrary ERC20 tokens don't need RocketStorage
+	if common.IsHexAddress(token) {
+		tokenContract, err := getErc20Contract(ec, common.HexToAddress(token))
+		if err != nil {
+			return nil, err
+		}
+		return getErc20Balance(tokenContract, opts.From)
+	}
+
+	// Get RocketStorage
+	if err := services.RequireRocketStorage(c); err != nil {
+		return nil, err
+	}
+	switch token {
+	case "rpl":
+		return tokens.GetRPLBalance(rp, opts.From, nil)
+	case "fsrpl":
+		return tokens.GetFixedSupplyRPLBalance(rp, opts.From, nil)
+	case "reth":
+		return tokens.GetRETHBalance(rp, opts.From, nil)
+	}
+	return nil, fmt.Errorf("Unknown token type '%s'", token)
+
+}
+
+// Create a binding for an arbitrary ERC20 token
+func getErc20Contract(ec rocketpool.ExecutionClient, tokenAddress common.Address) (*rocketpool.Contract, error) {
+	code, err := ec.CodeAt(context.Background(), tokenAddress, nil)
+	if err != nil {
+		return nil, fmt.Errorf("Error getting code for token %s: %w", tokenAddress.Hex(), err)
+	}
+	if len(code) == 0 {
+		return nil, fmt.Errorf("There is no contract deployed at token address %s.", tokenAddress.Hex())
+	}
+
+	erc20Abi, err := abi.JSON(strings.NewReader(contracts.ERC20ABI))
+	if err != nil {
+		return nil, err
+	}
+	return &rocketpool.Contract{
+		Contract: bind.NewBoundContract(tokenAddress, erc20Abi, ec, ec, ec),
+		Address:  &tokenAddress,
+		ABI:      &erc20Abi,
+		Client:   ec,
+	}, nil
+}
+
+// Get the name, symbol, and decimals of an ERC20 token
+func getErc20Details(tokenContract *rocketpool.Contract) (string, string, uint8, error) {
+	name := new(string)
+	if err := tokenContract.Call(nil, name, "name"); err != nil {
+		return "", "", 0, fmt.Errorf("Could not get token name: %w", err)
+	}
+	symbol := new(string)
+	if err := tokenContract.Call(nil, symbol, "symbol"); err != nil {
+		return "", "", 0, fmt.Errorf("Could not get token symbol: %w", err)
+	}
+	decimals := new(uint8)
+	if err := tokenContract.Call(nil, decimals, "decimals"); err != nil {
+		return "", "", 0, fmt.Errorf("Could not get token decimals: %w", err)
+	}
+	return *name, *symbol, *decimals, nil
+}
+
+// Get an address's balance of an ERC20 token
+func getErc20Balance(tokenContract *rocketpool.Contract, address common.Address) (*big.Int, error) {
+	balance := new(*big.Int)
+	if err := tokenContract.Call(nil, balance, "balanceOf", address); err != nil {
+		return nil, fmt.Errorf("Could not get token balance: %w", err)
+	}
+	return *balance, nil
+}
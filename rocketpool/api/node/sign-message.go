@@ -12,6 +12,9 @@ import (
 	hexutils "github.com/rocket-pool/smartnode/shared/utils/hex"
 )
 
+// The maximum number of messages that can be signed in one batch
+const MaxSignMessagesBatchSize int = 100
+
 func signMessage(c *cli.Context, message string) (*api.NodeSignResponse, error) {
 	// Get services
 	if err := services.RequireNodeRegistered(c); err != nil {
@@ -34,3 +37,29 @@ func signMessage(c *cli.Context, message string) (*api.NodeSignResponse, error)
 	return &response, nil
 
 }
+
+func signMessages(c *cli.Context, messages []string) (*api.NodeSignMessagesResponse, error) {
+	// Get services
+	if err := services.RequireNodeRegistered(c); err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.NodeSignMessagesResponse{}
+	signedBytes, err := w.SignMessages(messages)
+	if err != nil {
+		return nil, fmt.Errorf("Error signing messages: %w", err)
+	}
+	response.SignedData = make([]string, len(signedBytes))
+	for i, signature := range signedBytes {
+		response.SignedData[i] = hexutils.AddPrefix(hex.EncodeToString(signature))
+	}
+
+	// Return response
+	return &response, nil
+
+}
@@ -56,6 +56,7 @@ func getSmoothingPoolRegistrationStatus(c *cli.Context) (*api.GetSmoothingPoolRe
 	if err != nil {
 		return nil, err
 	}
+	response.RegistrationChangedTime = regChangeTime
 
 	// Get the rewards interval
 	intervalTime, err := rewards.GetClaimIntervalTime(rp, nil)
@@ -63,6 +64,27 @@ func getSmoothingPoolRegistrationStatus(c *cli.Context) (*api.GetSmoothingPoolRe
 		return nil, err
 	}
 
+	// Get the interval the status was last changed in, counting back from the current one by the current interval length
+	if regChangeTime.Unix() > 0 {
+		currentIndex, err := rewards.GetRewardIndex(rp, nil)
+		if err != nil {
+			return nil, err
+		}
+		intervalStart, err := rewards.GetClaimIntervalTimeStart(rp, nil)
+		if err != nil {
+			return nil, err
+		}
+		changedIndex := currentIndex.Uint64()
+		if regChangeTime.Before(intervalStart) {
+			intervalsBefore := uint64(intervalStart.Sub(regChangeTime)/intervalTime) + 1
+			if intervalsBefore > changedIndex {
+				intervalsBefore = changedIndex
+			}
+			changedIndex -= intervalsBefore
+		}
+		response.RegistrationChangedInterval = changedIndex
+	}
+
 	// Get the time the user can next change their opt-in status
 	latestBlockTimeUnix, err := services.GetEthClientLatestBlockTimestamp(ec)
 	if err != nil {
@@ -70,6 +92,7 @@ func getSmoothingPoolRegistrationStatus(c *cli.Context) (*api.GetSmoothingPoolRe
 	}
 	latestBlockTime := time.Unix(int64(latestBlockTimeUnix), 0)
 	changeAvailableTime := regChangeTime.Add(intervalTime)
+	response.ChangeAvailableTime = changeAvailableTime
 	response.TimeLeftUntilChangeable = changeAvailableTime.Sub(latestBlockTime)
 
 	// Return response
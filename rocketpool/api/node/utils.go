@@ -4,6 +4,7 @@ import (
 	"context"
 	"math/big"
 
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/rocket-pool/rocketpool-go/minipool"
 	"github.com/rocket-pool/rocketpool-go/rocketpool"
@@ -26,7 +27,13 @@ type minipoolCountDetails struct {
 }
 
 // Get all node minipool count details
-func getNodeMinipoolCountDetails(rp *rocketpool.RocketPool, nodeAddress common.Address) ([]minipoolCountDetails, error) {
+func getNodeMinipoolCountDetails(rp *rocketpool.RocketPool, nodeAddress common.Address, opts *bind.CallOpts) ([]minipoolCountDetails, error) {
+
+	// Get the block to query headers at
+	var blockNumber *big.Int
+	if opts != nil {
+		blockNumber = opts.BlockNumber
+	}
 
 	// Data
 	var wg1 errgroup.Group
@@ -36,13 +43,13 @@ func getNodeMinipoolCountDetails(rp *rocketpool.RocketPool, nodeAddress common.A
 	// Get minipool addresses
 	wg1.Go(func() error {
 		var err error
-		addresses, err = minipool.GetNodeMinipoolAddresses(rp, nodeAddress, nil)
+		addresses, err = minipool.GetNodeMinipoolAddresses(rp, nodeAddress, opts)
 		return err
 	})
 
 	// Get current block
 	wg1.Go(func() error {
-		header, err := rp.Client.HeaderByNumber(context.Background(), nil)
+		header, err := rp.Client.HeaderByNumber(context.Background(), blockNumber)
 		if err == nil {
 			currentBlock = header.Number.Uint64()
 		}
@@ -71,7 +78,7 @@ func getNodeMinipoolCountDetails(rp *rocketpool.RocketPool, nodeAddress common.A
 			mi := mi
 			wg.Go(func() error {
 				address := addresses[mi]
-				mpDetails, err := getMinipoolCountDetails(rp, address, currentBlock)
+				mpDetails, err := getMinipoolCountDetails(rp, address, currentBlock, opts)
 				if err == nil {
 					details[mi] = mpDetails
 				}
@@ -90,7 +97,7 @@ func getNodeMinipoolCountDetails(rp *rocketpool.RocketPool, nodeAddress common.A
 }
 
 // Get a minipool's count details
-func getMinipoolCountDetails(rp *rocketpool.RocketPool, minipoolAddress common.Address, currentBlock uint64) (minipoolCountDetails, error) {
+func getMinipoolCountDetails(rp *rocketpool.RocketPool, minipoolAddress common.Address, currentBlock uint64, opts *bind.CallOpts) (minipoolCountDetails, error) {
 
 	// Create minipool
 	mp, err := minipool.NewMinipool(rp, minipoolAddress)
@@ -108,22 +115,22 @@ func getMinipoolCountDetails(rp *rocketpool.RocketPool, minipoolAddress common.A
 	// Load data
 	wg.Go(func() error {
 		var err error
-		status, err = mp.GetStatus(nil)
+		status, err = mp.GetStatus(opts)
 		return err
 	})
 	wg.Go(func() error {
 		var err error
-		refundBalance, err = mp.GetNodeRefundBalance(nil)
+		refundBalance, err = mp.GetNodeRefundBalance(opts)
 		return err
 	})
 	wg.Go(func() error {
 		var err error
-		finalised, err = mp.GetFinalised(nil)
+		finalised, err = mp.GetFinalised(opts)
 		return err
 	})
 	wg.Go(func() error {
 		var err error
-		penaltyCount, err = minipool.GetMinipoolPenaltyCount(rp, minipoolAddress, nil)
+		penaltyCount, err = minipool.GetMinipoolPenaltyCount(rp, minipoolAddress, opts)
 		return err
 	})
 
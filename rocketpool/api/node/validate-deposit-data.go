@@ -0,0 +1,57 @@
+package node
+
+import (
+	"encoding/hex"
+
+	"github.com/ethereum/go-ethereum/common"
+	rptypes "github.com/rocket-pool/rocketpool-go/types"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+	"github.com/rocket-pool/smartnode/shared/utils/validator"
+)
+
+func validateDepositData(c *cli.Context, pubkey rptypes.ValidatorPubkey, withdrawalCredentials common.Hash, amountGwei uint64, signature rptypes.ValidatorSignature) (*api.ValidateDepositDataResponse, error) {
+
+	// Get services
+	if err := services.RequireBeaconClientSynced(c); err != nil {
+		return nil, err
+	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.ValidateDepositDataResponse{}
+
+	// Get the eth2 config for the current network
+	eth2Config, err := validator.GetDepositEth2Config(cfg, bc)
+	if err != nil {
+		return nil, err
+	}
+	response.GenesisForkVersion = hex.EncodeToString(eth2Config.GenesisForkVersion)
+
+	// Compute the deposit data root
+	response.DepositDataRoot, err = validator.GetDepositDataRoot(amountGwei, pubkey, withdrawalCredentials, signature)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check the signature against the network's deposit domain
+	err = validator.ValidateDepositInfo(eth2Config, amountGwei, pubkey, withdrawalCredentials, signature)
+	if err != nil {
+		response.ValidationError = err.Error()
+	} else {
+		response.Valid = true
+	}
+
+	// Return response
+	return &response, nil
+
+}
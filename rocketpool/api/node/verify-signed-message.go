@@ -0,0 +1,42 @@
+package node
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/wallet"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+	hexutils "github.com/rocket-pool/smartnode/shared/utils/hex"
+)
+
+func verifySignedMessage(c *cli.Context, message string, signature string, signer common.Address) (*api.NodeVerifySignedMessageResponse, error) {
+	// Get services
+	if err := services.RequireEthClientSynced(c); err != nil {
+		return nil, err
+	}
+	ec, err := services.GetEthClient(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Decode the signature
+	signatureBytes, err := hex.DecodeString(hexutils.RemovePrefix(signature))
+	if err != nil {
+		return nil, fmt.Errorf("Invalid signature [%s]: %w", signature, err)
+	}
+
+	// Response
+	response := api.NodeVerifySignedMessageResponse{}
+	response.Valid, err = wallet.VerifySignature(ec, message, signatureBytes, signer)
+	if err != nil {
+		return nil, fmt.Errorf("Error verifying signature: %w", err)
+	}
+
+	// Return response
+	return &response, nil
+
+}
@@ -0,0 +1,131 @@
+package node
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/rocket-pool/rocketpool-go/minipool"
+	"github.com/rocket-pool/rocketpool-go/node"
+	"github.com/urfave/cli"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	rprewards "github.com/rocket-pool/smartnode/shared/services/rewards"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+// Get how much ETH the node can withdraw right now across its fee distributor, minipool refunds, and unclaimed Smoothing Pool rewards
+func canWithdrawEth(c *cli.Context) (*api.NodeCanWithdrawEthResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeRegistered(c); err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.NodeCanWithdrawEthResponse{
+		MinipoolRefunds:      []api.NodeMinipoolRefund{},
+		MinipoolRefundsTotal: big.NewInt(0),
+		UnclaimedRewards:     big.NewInt(0),
+	}
+
+	// Get node account
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+
+	// Sync
+	var wg errgroup.Group
+
+	// Get the node's share of the fee distributor balance
+	wg.Go(func() error {
+		distributorAddress, err := node.GetDistributorAddress(rp, nodeAccount.Address, nil)
+		if err != nil {
+			return err
+		}
+		balance, err := rp.Client.BalanceAt(context.Background(), distributorAddress, nil)
+		if err != nil {
+			return err
+		}
+		averageNodeFee, err := node.GetNodeAverageFee(rp, nodeAccount.Address, nil)
+		if err != nil {
+			return err
+		}
+		response.FeeDistributorShare = getDistributorNodeShare(balance, averageNodeFee)
+		return nil
+	})
+
+	// Get the refund balances of the node's minipools
+	wg.Go(func() error {
+		addresses, err := minipool.GetNodeMinipoolAddresses(rp, nodeAccount.Address, nil)
+		if err != nil {
+			return err
+		}
+		for _, address := range addresses {
+			mp, err := minipool.NewMinipool(rp, address)
+			if err != nil {
+				return err
+			}
+			refundBalance, err := mp.GetNodeRefundBalance(nil)
+			if err != nil {
+				return err
+			}
+			if refundBalance.Cmp(big.NewInt(0)) > 0 {
+				response.MinipoolRefunds = append(response.MinipoolRefunds, api.NodeMinipoolRefund{
+					Address: address,
+					Amount:  refundBalance,
+				})
+				response.MinipoolRefundsTotal.Add(response.MinipoolRefundsTotal, refundBalance)
+			}
+		}
+		return nil
+	})
+
+	// Get the unclaimed Smoothing Pool rewards
+	wg.Go(func() error {
+		unclaimed, _, err := rprewards.GetClaimStatus(rp, nodeAccount.Address, nil)
+		if err != nil {
+			return err
+		}
+		for _, unclaimedInterval := range unclaimed {
+			intervalInfo, err := rprewards.GetIntervalInfo(rp, cfg, nodeAccount.Address, unclaimedInterval)
+			if err != nil {
+				return err
+			}
+			if !intervalInfo.TreeFileExists {
+				return fmt.Errorf("Error calculating unclaimed rewards: rewards file %s doesn't exist and interval %d is unclaimed", intervalInfo.TreeFilePath, unclaimedInterval)
+			}
+			if intervalInfo.NodeExists {
+				response.UnclaimedRewards.Add(response.UnclaimedRewards, &intervalInfo.SmoothingPoolEthAmount.Int)
+			}
+		}
+		return nil
+	})
+
+	// Wait for data
+	if err := wg.Wait(); err != nil {
+		return nil, err
+	}
+
+	// Get the total
+	response.TotalWithdrawable = new(big.Int).Add(response.FeeDistributorShare, response.MinipoolRefundsTotal)
+	response.TotalWithdrawable.Add(response.TotalWithdrawable, response.UnclaimedRewards)
+
+	// Return response
+	return &response, nil
+
+}
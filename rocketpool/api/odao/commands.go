@@ -72,6 +72,24 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 				},
 			},
 
+			{
+				Name:      "active-proposals",
+				Usage:     "Get a summary of the oracle DAO proposals that are currently open for voting",
+				UsageText: "rocketpool api odao active-proposals",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(getActiveProposals(c))
+					return nil
+
+				},
+			},
+
 			{
 				Name:      "proposal-details",
 				Aliases:   []string{"d"},
@@ -184,6 +202,41 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 				},
 			},
 
+			{
+				Name:      "can-propose-replace",
+				Usage:     "Check whether the node can propose replacing a member with a new member, and get the proposals required to do so",
+				UsageText: "rocketpool api odao can-propose-replace leaving-member-address member-address member-id member-url fine-amount",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 5); err != nil {
+						return err
+					}
+					leavingAddress, err := cliutils.ValidateAddress("leaving member address", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+					memberAddress, err := cliutils.ValidateAddress("member address", c.Args().Get(1))
+					if err != nil {
+						return err
+					}
+					memberId, err := cliutils.ValidateDAOMemberID("member ID", c.Args().Get(2))
+					if err != nil {
+						return err
+					}
+
+					fineAmountWei, err := cliutils.ValidatePositiveOrZeroWeiAmount("fine amount", c.Args().Get(4))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(canProposeReplace(c, leavingAddress, memberAddress, memberId, c.Args().Get(3), fineAmountWei))
+					return nil
+
+				},
+			},
+
 			{
 				Name:      "can-propose-kick",
 				Usage:     "Check whether the node can propose kicking a member",
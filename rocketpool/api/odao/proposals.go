@@ -1,7 +1,11 @@
 package odao
 
 import (
+	"time"
+
 	"github.com/rocket-pool/rocketpool-go/dao"
+	"github.com/rocket-pool/rocketpool-go/dao/trustednode"
+	rptypes "github.com/rocket-pool/rocketpool-go/types"
 	"github.com/urfave/cli"
 
 	"github.com/rocket-pool/smartnode/shared/services"
@@ -83,6 +87,70 @@ func getProposal(c *cli.Context, id uint64) (*api.TNDAOProposalResponse, error)
 
 	response.Proposals = proposal
 
+	// Decode the payload
+	response.PayloadFunction, response.PayloadArguments, err = decodeProposalPayload(rp, proposal.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return response
+	return &response, nil
+
+}
+
+func getActiveProposals(c *cli.Context) (*api.TNDAOActiveProposalsResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeTrusted(c); err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.TNDAOActiveProposalsResponse{}
+
+	// Get node account
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the node's joined time
+	memberJoinedTime, err := trustednode.GetMemberJoinedTime(rp, nodeAccount.Address, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get proposals
+	proposals, err := dao.GetDAOProposalsWithMember(rp, "rocketDAONodeTrustedProposals", nodeAccount.Address, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Summarize the active ones
+	now := time.Now()
+	response.Proposals = []api.TNDAOActiveProposal{}
+	for _, proposal := range proposals {
+		if proposal.State != rptypes.Active {
+			continue
+		}
+		activeProposal := api.TNDAOActiveProposal{
+			Proposal:           proposal,
+			TimeRemaining:      time.Unix(int64(proposal.EndTime), 0).Sub(now),
+			QuorumReached:      (proposal.VotesFor >= proposal.VotesRequired),
+			JoinedAfterCreated: (memberJoinedTime >= proposal.CreatedTime),
+		}
+		activeProposal.NeedsVote = !(proposal.MemberVoted || activeProposal.JoinedAfterCreated)
+		response.Proposals = append(response.Proposals, activeProposal)
+	}
+
 	// Return response
 	return &response, nil
 
@@ -34,6 +34,17 @@ func canProposeKick(c *cli.Context, memberAddress common.Address, fineAmountWei
 	// Response
 	response := api.CanProposeTNDAOKickResponse{}
 
+	// Check that the target is an existing member; the remaining checks depend on it
+	memberExists, err := trustednode.GetMemberExists(rp, memberAddress, nil)
+	if err != nil {
+		return nil, err
+	}
+	if !memberExists {
+		response.MemberDoesNotExist = true
+		response.CanPropose = false
+		return &response, nil
+	}
+
 	// Sync
 	var wg errgroup.Group
 
@@ -110,6 +121,15 @@ func proposeKick(c *cli.Context, memberAddress common.Address, fineAmountWei *bi
 	// Response
 	response := api.ProposeTNDAOKickResponse{}
 
+	// Check that the target is an existing member
+	memberExists, err := trustednode.GetMemberExists(rp, memberAddress, nil)
+	if err != nil {
+		return nil, err
+	}
+	if !memberExists {
+		return nil, fmt.Errorf("%s is not a member of the Oracle DAO.", memberAddress.Hex())
+	}
+
 	// Data
 	var wg errgroup.Group
 	var memberId string
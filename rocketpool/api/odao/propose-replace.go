@@ -0,0 +1,157 @@
+package odao
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/rocketpool-go/dao/trustednode"
+	"github.com/rocket-pool/rocketpool-go/utils/eth"
+	"github.com/urfave/cli"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+	"github.com/rocket-pool/smartnode/shared/utils/math"
+)
+
+// Check whether the node can propose replacing a member with a new one, and build the proposals required to do so.
+// A replacement is an invite for the incoming member followed by a leave proposal (if the leaving member is this node)
+// or a kick proposal with the given fine (if it's another member; the fine is ignored for a leave proposal).
+// The proposal cooldown applies between them, so they're returned for the node to submit in order rather than submitted here.
+func canProposeReplace(c *cli.Context, leavingAddress common.Address, memberAddress common.Address, memberId, memberUrl string, fineAmountWei *big.Int) (*api.CanProposeTNDAOReplaceResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeTrusted(c); err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.CanProposeTNDAOReplaceResponse{}
+
+	// Get node account
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+
+	// Sync
+	var wg errgroup.Group
+	var leavingMemberId string
+	var leavingMemberUrl string
+
+	// Check if proposal cooldown is active
+	wg.Go(func() error {
+		proposalCooldownActive, err := getProposalCooldownActive(rp, nodeAccount.Address)
+		if err == nil {
+			response.ProposalCooldownActive = proposalCooldownActive
+		}
+		return err
+	})
+
+	// Check if the leaving member exists
+	wg.Go(func() error {
+		leavingMemberExists, err := trustednode.GetMemberExists(rp, leavingAddress, nil)
+		if err == nil {
+			response.LeavingMemberDoesNotExist = !leavingMemberExists
+		}
+		return err
+	})
+
+	// Check if the incoming member exists
+	wg.Go(func() error {
+		memberExists, err := trustednode.GetMemberExists(rp, memberAddress, nil)
+		if err == nil {
+			response.MemberAlreadyExists = memberExists
+		}
+		return err
+	})
+
+	// Check the leaving member's RPL bond covers the fine if they're being kicked
+	isLeaving := bytes.Equal(leavingAddress.Bytes(), nodeAccount.Address.Bytes())
+	if !isLeaving {
+		wg.Go(func() error {
+			rplBondAmount, err := trustednode.GetMemberRPLBondAmount(rp, leavingAddress, nil)
+			if err == nil {
+				response.InsufficientRplBond = (fineAmountWei.Cmp(rplBondAmount) > 0)
+			}
+			return err
+		})
+	}
+
+	// Get the leaving member's details
+	wg.Go(func() error {
+		var err error
+		leavingMemberId, err = trustednode.GetMemberID(rp, leavingAddress, nil)
+		return err
+	})
+	wg.Go(func() error {
+		var err error
+		leavingMemberUrl, err = trustednode.GetMemberUrl(rp, leavingAddress, nil)
+		return err
+	})
+
+	// Wait for data
+	if err := wg.Wait(); err != nil {
+		return nil, err
+	}
+
+	// Check the proposals can be built
+	response.CanPropose = !(response.ProposalCooldownActive || response.LeavingMemberDoesNotExist || response.MemberAlreadyExists || response.InsufficientRplBond)
+	if !response.CanPropose {
+		return &response, nil
+	}
+
+	// Get transactor
+	opts, err := w.GetNodeAccountTransactor()
+	if err != nil {
+		return nil, err
+	}
+
+	// Build the invite proposal
+	inviteProposal := api.TNDAOReplacementProposal{
+		Type:          "invite",
+		Message:       fmt.Sprintf("invite %s (%s)", memberId, memberUrl),
+		MemberAddress: memberAddress,
+		MemberId:      memberId,
+		MemberUrl:     memberUrl,
+	}
+	gasInfo, err := trustednode.EstimateProposeInviteMemberGas(rp, inviteProposal.Message, memberAddress, memberId, memberUrl, opts)
+	if err == nil {
+		inviteProposal.GasInfo = gasInfo
+	}
+
+	// Build the leave or kick proposal
+	removeProposal := api.TNDAOReplacementProposal{
+		MemberAddress: leavingAddress,
+		MemberId:      leavingMemberId,
+		MemberUrl:     leavingMemberUrl,
+	}
+	if isLeaving {
+		removeProposal.Type = "leave"
+		removeProposal.Message = fmt.Sprintf("%s (%s) leaves", leavingMemberId, leavingMemberUrl)
+		gasInfo, err = trustednode.EstimateProposeMemberLeaveGas(rp, removeProposal.Message, leavingAddress, opts)
+	} else {
+		removeProposal.Type = "kick"
+		removeProposal.FineAmount = fineAmountWei
+		removeProposal.Message = fmt.Sprintf("kick %s (%s) with %.6f RPL fine", leavingMemberId, leavingMemberUrl, math.RoundDown(eth.WeiToEth(fineAmountWei), 6))
+		gasInfo, err = trustednode.EstimateProposeKickMemberGas(rp, removeProposal.Message, leavingAddress, fineAmountWei, opts)
+	}
+	if err == nil {
+		removeProposal.GasInfo = gasInfo
+	}
+
+	// Return response
+	response.Proposals = []api.TNDAOReplacementProposal{inviteProposal, removeProposal}
+	return &response, nil
+
+}
@@ -1,6 +1,7 @@
 package odao
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -10,6 +11,8 @@ import (
 	tnsettings "github.com/rocket-pool/rocketpool-go/settings/trustednode"
 	rptypes "github.com/rocket-pool/rocketpool-go/types"
 	"golang.org/x/sync/errgroup"
+
+	"github.com/rocket-pool/smartnode/shared/types/api"
 )
 
 // Settings
@@ -153,3 +156,41 @@ func getProposalStates(rp *rocketpool.RocketPool) ([]rptypes.ProposalState, erro
 	return states, nil
 
 }
+
+// Decode an oracle DAO proposal payload into the name of the function it executes and its arguments.
+// Payloads that don't match a known proposal function are left undecoded.
+func decodeProposalPayload(rp *rocketpool.RocketPool, payload []byte) (string, []api.ProposalPayloadArgument, error) {
+
+	// Get the oracle DAO proposals contract, which executes the payload
+	proposalsContract, err := rp.GetContract("rocketDAONodeTrustedProposals")
+	if err != nil {
+		return "", nil, fmt.Errorf("Error getting oracle DAO proposals contract: %w", err)
+	}
+
+	// Find the function
+	if len(payload) < 4 {
+		return "", nil, nil
+	}
+	method, err := proposalsContract.ABI.MethodById(payload[:4])
+	if err != nil {
+		return "", nil, nil
+	}
+
+	// Decode the arguments
+	values, err := method.Inputs.Unpack(payload[4:])
+	if err != nil {
+		return "", nil, nil
+	}
+	arguments := make([]api.ProposalPayloadArgument, len(values))
+	for i, value := range values {
+		arguments[i] = api.ProposalPayloadArgument{
+			Name:  method.Inputs[i].Name,
+			Type:  method.Inputs[i].Type.String(),
+			Value: fmt.Sprint(value),
+		}
+	}
+
+	// Return
+	return method.Name, arguments, nil
+
+}
@@ -0,0 +1,197 @@
+package pdao
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/urfave/cli"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+	"github.com/rocket-pool/smartnode/shared/utils/eth1"
+)
+
+func getBootstrapStatus(c *cli.Context) (*api.PDAOBootstrapStatusResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeWallet(c); err != nil {
+		return nil, err
+	}
+	if err := services.RequireRocketStorage(c); err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.PDAOBootstrapStatusResponse{}
+
+	// Get node account
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+
+	// Sync
+	var wg errgroup.Group
+
+	// Get bootstrap mode status
+	wg.Go(func() error {
+		bootstrapModeDisabled, err := getBootstrapModeDisabled(rp)
+		if err == nil {
+			response.BootstrapModeActive = !bootstrapModeDisabled
+		}
+		return err
+	})
+
+	// Get the guardian
+	wg.Go(func() error {
+		var err error
+		response.Guardian, err = getGuardian(rp)
+		return err
+	})
+
+	// Wait for data
+	if err := wg.Wait(); err != nil {
+		return nil, err
+	}
+
+	// Return response
+	response.IsGuardian = bytes.Equal(response.Guardian.Bytes(), nodeAccount.Address.Bytes())
+	return &response, nil
+
+}
+
+func canBootstrapSetting(c *cli.Context, contractName string, settingPath string, method string, value interface{}) (*api.CanPDAOBootstrapSettingResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeWallet(c); err != nil {
+		return nil, err
+	}
+	if err := services.RequireRocketStorage(c); err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.CanPDAOBootstrapSettingResponse{}
+
+	// Get node account
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+
+	// Sync
+	var wg errgroup.Group
+
+	// Check bootstrap mode status
+	wg.Go(func() error {
+		bootstrapModeDisabled, err := getBootstrapModeDisabled(rp)
+		if err == nil {
+			response.BootstrapModeDisabled = bootstrapModeDisabled
+		}
+		return err
+	})
+
+	// Check if the node is the guardian
+	wg.Go(func() error {
+		guardian, err := getGuardian(rp)
+		if err == nil {
+			response.NotGuardian = !bytes.Equal(guardian.Bytes(), nodeAccount.Address.Bytes())
+		}
+		return err
+	})
+
+	// Wait for data
+	if err := wg.Wait(); err != nil {
+		return nil, err
+	}
+
+	// Get gas estimate
+	response.CanBootstrap = !(response.BootstrapModeDisabled || response.NotGuardian)
+	if response.CanBootstrap {
+		rocketDAOProtocol, err := rp.GetContract("rocketDAOProtocol")
+		if err != nil {
+			return nil, err
+		}
+		opts, err := w.GetNodeAccountTransactor()
+		if err != nil {
+			return nil, err
+		}
+		gasInfo, err := rocketDAOProtocol.GetTransactionGasInfo(opts, method, contractName, settingPath, value)
+		if err != nil {
+			return nil, fmt.Errorf("Could not estimate the gas required to bootstrap %s.%s: %w", contractName, settingPath, err)
+		}
+		response.GasInfo = gasInfo
+	}
+
+	// Return response
+	return &response, nil
+
+}
+
+func bootstrapSetting(c *cli.Context, contractName string, settingPath string, method string, value interface{}) (*api.PDAOBootstrapSettingResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeWallet(c); err != nil {
+		return nil, err
+	}
+	if err := services.RequireRocketStorage(c); err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.PDAOBootstrapSettingResponse{}
+
+	// Get the protocol DAO contract
+	rocketDAOProtocol, err := rp.GetContract("rocketDAOProtocol")
+	if err != nil {
+		return nil, err
+	}
+
+	// Get transactor
+	opts, err := w.GetNodeAccountTransactor()
+	if err != nil {
+		return nil, err
+	}
+
+	// Override the provided pending TX if requested
+	err = eth1.CheckForNonceOverride(c, opts)
+	if err != nil {
+		return nil, fmt.Errorf("Error checking for nonce override: %w", err)
+	}
+
+	// Bootstrap the setting
+	hash, err := rocketDAOProtocol.Transact(opts, method, contractName, settingPath, value)
+	if err != nil {
+		return nil, fmt.Errorf("Could not bootstrap %s.%s: %w", contractName, settingPath, err)
+	}
+	response.TxHash = hash
+
+	// Return response
+	return &response, nil
+
+}
@@ -0,0 +1,81 @@
+package pdao
+
+import (
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/utils/api"
+	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
+)
+
+// Register subcommands
+func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
+	command.Subcommands = append(command.Subcommands, cli.Command{
+		Name:    name,
+		Aliases: aliases,
+		Usage:   "Manage the Rocket Pool protocol DAO",
+		Subcommands: []cli.Command{
+
+			{
+				Name:      "bootstrap-status",
+				Aliases:   []string{"b"},
+				Usage:     "Check whether the protocol DAO is still in bootstrap mode, and whether the node is its guardian",
+				UsageText: "rocketpool api pdao bootstrap-status",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(getBootstrapStatus(c))
+					return nil
+
+				},
+			},
+
+			{
+				Name:      "can-bootstrap-setting",
+				Usage:     "Check whether the node can change a protocol DAO setting via bootstrap mode",
+				UsageText: "rocketpool api pdao can-bootstrap-setting contract-name setting-path value-type value",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 4); err != nil {
+						return err
+					}
+					method, value, err := parseBootstrapValue(c.Args().Get(2), c.Args().Get(3))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(canBootstrapSetting(c, c.Args().Get(0), c.Args().Get(1), method, value))
+					return nil
+
+				},
+			},
+			{
+				Name:      "bootstrap-setting",
+				Usage:     "Change a protocol DAO setting via bootstrap mode",
+				UsageText: "rocketpool api pdao bootstrap-setting contract-name setting-path value-type value",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 4); err != nil {
+						return err
+					}
+					method, value, err := parseBootstrapValue(c.Args().Get(2), c.Args().Get(3))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(bootstrapSetting(c, c.Args().Get(0), c.Args().Get(1), method, value))
+					return nil
+
+				},
+			},
+		},
+	})
+}
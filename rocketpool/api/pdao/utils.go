@@ -0,0 +1,48 @@
+package pdao
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/rocketpool-go/rocketpool"
+
+	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
+)
+
+// Get whether the protocol DAO's bootstrap mode has been disabled
+func getBootstrapModeDisabled(rp *rocketpool.RocketPool) (bool, error) {
+	rocketDAOProtocol, err := rp.GetContract("rocketDAOProtocol")
+	if err != nil {
+		return false, err
+	}
+	bootstrapModeDisabled := new(bool)
+	if err := rocketDAOProtocol.Call(nil, bootstrapModeDisabled, "getBootstrapModeDisabled"); err != nil {
+		return false, fmt.Errorf("Could not get protocol DAO bootstrap mode status: %w", err)
+	}
+	return *bootstrapModeDisabled, nil
+}
+
+// Get the address of the guardian that controls bootstrap mode
+func getGuardian(rp *rocketpool.RocketPool) (common.Address, error) {
+	guardian := new(common.Address)
+	if err := rp.RocketStorageContract.Call(nil, guardian, "getGuardian"); err != nil {
+		return common.Address{}, fmt.Errorf("Could not get guardian address: %w", err)
+	}
+	return *guardian, nil
+}
+
+// Parse a bootstrap setting value of the given type, returning the protocol DAO function that sets it
+func parseBootstrapValue(valueType, value string) (string, interface{}, error) {
+	switch valueType {
+	case "uint":
+		uintValue, err := cliutils.ValidateBigInt("setting value", value)
+		return "bootstrapSettingUint", uintValue, err
+	case "bool":
+		boolValue, err := cliutils.ValidateBool("setting value", value)
+		return "bootstrapSettingBool", boolValue, err
+	case "address":
+		addressValue, err := cliutils.ValidateAddress("setting value", value)
+		return "bootstrapSettingAddress", addressValue, err
+	}
+	return "", nil, fmt.Errorf("Invalid setting value type '%s' - valid types are 'uint', 'bool' and 'address'", valueType)
+}
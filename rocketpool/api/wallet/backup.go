@@ -0,0 +1,276 @@
+package wallet
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/urfave/cli"
+	eth2ks "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+// Settings
+const (
+	BackupVersion        = 1
+	BackupWalletName     = "wallet"
+	BackupPasswordName   = "password"
+	BackupValidatorsName = "validators"
+	BackupDirMode        = 0750
+)
+
+// An encrypted backup bundle
+type backupBundle struct {
+	Version uint                   `json:"version"`
+	Crypto  map[string]interface{} `json:"crypto"`
+}
+
+// A file read from a backup archive and the path it will be restored to
+type backupFile struct {
+	name        string
+	destination string
+	contents    []byte
+	mode        os.FileMode
+}
+
+func exportBackup(c *cli.Context, passphrase string) (*api.ExportWalletBackupResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeWallet(c); err != nil {
+		return nil, err
+	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.ExportWalletBackupResponse{}
+
+	// Build the archive
+	var archive bytes.Buffer
+	gzipWriter := gzip.NewWriter(&archive)
+	tarWriter := tar.NewWriter(gzipWriter)
+	if err := addFileToBackup(tarWriter, cfg.Smartnode.GetWalletPath(), BackupWalletName); err != nil {
+		return nil, err
+	}
+	if err := addFileToBackup(tarWriter, cfg.Smartnode.GetPasswordPath(), BackupPasswordName); err != nil {
+		return nil, err
+	}
+	if c.Bool("include-validator-keys") {
+		keychainPath := cfg.Smartnode.GetValidatorKeychainPath()
+		err := filepath.Walk(keychainPath, func(filePath string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if !info.Mode().IsRegular() {
+				return nil
+			}
+			relativePath, err := filepath.Rel(keychainPath, filePath)
+			if err != nil {
+				return err
+			}
+			response.ValidatorKeyFileCount++
+			return addFileToBackup(tarWriter, filePath, path.Join(BackupValidatorsName, filepath.ToSlash(relativePath)))
+		})
+		if err != nil && !os.IsNotExist(err) {
+			return nil, fmt.Errorf("Error adding validator keys to the backup: %w", err)
+		}
+	}
+	if err := tarWriter.Close(); err != nil {
+		return nil, fmt.Errorf("Error creating backup archive: %w", err)
+	}
+	if err := gzipWriter.Close(); err != nil {
+		return nil, fmt.Errorf("Error creating backup archive: %w", err)
+	}
+
+	// Encrypt the archive
+	encryptedArchive, err := eth2ks.New().Encrypt(archive.Bytes(), passphrase)
+	if err != nil {
+		return nil, fmt.Errorf("Error encrypting backup: %w", err)
+	}
+	bundle, err := json.Marshal(backupBundle{
+		Version: BackupVersion,
+		Crypto:  encryptedArchive,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error serializing backup: %w", err)
+	}
+	response.Backup = string(bundle)
+
+	// Return response
+	return &response, nil
+
+}
+
+func importBackup(c *cli.Context, backupPath string, passphrase string) (*api.ImportWalletBackupResponse, error) {
+
+	// Get services
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.ImportWalletBackupResponse{}
+
+	// Check if wallet is already initialized
+	if w.IsInitialized() {
+		return nil, errors.New("the wallet is already initialized")
+	}
+
+	// Don't replace an existing password unless asked to
+	if !c.Bool("force") {
+		if _, err := os.Stat(cfg.Smartnode.GetPasswordPath()); err == nil {
+			return nil, errors.New("a node password is already set; use --force to overwrite it with the one in the backup")
+		}
+	}
+
+	// Read the backup
+	backup, err := ioutil.ReadFile(backupPath)
+	if err != nil {
+		return nil, fmt.Errorf("Error reading backup from %s: %w", backupPath, err)
+	}
+
+	// Decrypt the archive
+	var bundle backupBundle
+	if err := json.Unmarshal(backup, &bundle); err != nil {
+		return nil, fmt.Errorf("Error decoding backup: %w", err)
+	}
+	if bundle.Version != BackupVersion {
+		return nil, fmt.Errorf("Unsupported backup version %d", bundle.Version)
+	}
+	archive, err := eth2ks.New().Decrypt(bundle.Crypto, passphrase)
+	if err != nil {
+		return nil, fmt.Errorf("Error decrypting backup (is the passphrase correct?): %w", err)
+	}
+
+	// Read the files in the archive
+	gzipReader, err := gzip.NewReader(bytes.NewReader(archive))
+	if err != nil {
+		return nil, fmt.Errorf("Error reading backup archive: %w", err)
+	}
+	tarReader := tar.NewReader(gzipReader)
+	files := []backupFile{}
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("Error reading backup archive: %w", err)
+		}
+		destination, err := getBackupFileDestination(cfg, header.Name)
+		if err != nil {
+			return nil, err
+		}
+		contents, err := ioutil.ReadAll(tarReader)
+		if err != nil {
+			return nil, fmt.Errorf("Error reading %s from backup archive: %w", header.Name, err)
+		}
+		files = append(files, backupFile{
+			name:        header.Name,
+			destination: destination,
+			contents:    contents,
+			mode:        os.FileMode(header.Mode).Perm(),
+		})
+	}
+
+	// Don't replace existing validator key files unless asked to; this is checked before anything is restored so a refusal doesn't leave a partial restore behind
+	if !c.Bool("force") {
+		existingFiles := []string{}
+		for _, file := range files {
+			if file.name == BackupWalletName || file.name == BackupPasswordName {
+				continue
+			}
+			if _, err := os.Stat(file.destination); err == nil {
+				existingFiles = append(existingFiles, file.destination)
+			} else if !os.IsNotExist(err) {
+				return nil, fmt.Errorf("Error checking %s: %w", file.destination, err)
+			}
+		}
+		if len(existingFiles) > 0 {
+			return nil, fmt.Errorf("%d validator key files in the backup already exist (%s); use --force to overwrite them with the ones in the backup", len(existingFiles), strings.Join(existingFiles, ", "))
+		}
+	}
+
+	// Restore the files
+	for _, file := range files {
+		if err := os.MkdirAll(filepath.Dir(file.destination), BackupDirMode); err != nil {
+			return nil, fmt.Errorf("Error creating folder for %s: %w", file.destination, err)
+		}
+		if err := ioutil.WriteFile(file.destination, file.contents, file.mode); err != nil {
+			return nil, fmt.Errorf("Error restoring %s: %w", file.destination, err)
+		}
+		switch file.name {
+		case BackupWalletName:
+			response.RestoredWallet = true
+		case BackupPasswordName:
+			response.RestoredPassword = true
+		default:
+			response.ValidatorKeyFileCount++
+		}
+	}
+
+	// Return response
+	return &response, nil
+
+}
+
+// Add a file to a backup archive under the given name
+func addFileToBackup(tarWriter *tar.Writer, filePath string, name string) error {
+	info, err := os.Stat(filePath)
+	if err != nil {
+		return fmt.Errorf("Error reading %s: %w", filePath, err)
+	}
+	contents, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		return fmt.Errorf("Error reading %s: %w", filePath, err)
+	}
+	header, err := tar.FileInfoHeader(info, "")
+	if err != nil {
+		return fmt.Errorf("Error creating backup entry for %s: %w", filePath, err)
+	}
+	header.Name = name
+	if err := tarWriter.WriteHeader(header); err != nil {
+		return fmt.Errorf("Error adding %s to backup: %w", filePath, err)
+	}
+	if _, err := tarWriter.Write(contents); err != nil {
+		return fmt.Errorf("Error adding %s to backup: %w", filePath, err)
+	}
+	return nil
+}
+
+// Get the path a file in a backup archive should be restored to
+func getBackupFileDestination(cfg *config.RocketPoolConfig, name string) (string, error) {
+	switch name {
+	case BackupWalletName:
+		return cfg.Smartnode.GetWalletPath(), nil
+	case BackupPasswordName:
+		return cfg.Smartnode.GetPasswordPath(), nil
+	}
+
+	// Make sure validator keys can't be written outside of the keychain folder
+	relativePath := strings.TrimPrefix(name, BackupValidatorsName+"/")
+	cleanPath := path.Clean("/" + relativePath)
+	if relativePath == name || cleanPath == "/" || cleanPath != "/"+relativePath {
+		return "", fmt.Errorf("Backup contains an invalid file [%s]", name)
+	}
+	return filepath.Join(cfg.Smartnode.GetValidatorKeychainPath(), filepath.FromSlash(relativePath)), nil
+}
@@ -1,6 +1,10 @@
 package wallet
 
 import (
+	"fmt"
+	"strings"
+
+	"github.com/rocket-pool/rocketpool-go/types"
 	"github.com/urfave/cli"
 
 	"github.com/rocket-pool/smartnode/shared/utils/api"
@@ -34,6 +38,92 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 				},
 			},
 
+			{
+				Name:      "status-details",
+				Usage:     "Get the location and state of each of the node wallet's files",
+				UsageText: "rocketpool api wallet status-details",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(getStatusDetails(c))
+					return nil
+
+				},
+			},
+
+			{
+				Name:      "export-validator-keys",
+				Usage:     "Export validator keys as EIP-2335 keystores along with their passwords. This is sensitive: anyone with the output can run (and get slashed with) the validators.",
+				UsageText: "rocketpool api wallet export-validator-keys [pubkeys]",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if c.NArg() > 1 {
+						return fmt.Errorf("Incorrect argument count; usage: %s", c.Command.UsageText)
+					}
+					pubkeys := []types.ValidatorPubkey{}
+					if c.NArg() == 1 {
+						for _, pubkeyString := range strings.Split(c.Args().Get(0), ",") {
+							pubkey, err := cliutils.ValidatePubkey("pubkey", pubkeyString)
+							if err != nil {
+								return err
+							}
+							pubkeys = append(pubkeys, pubkey)
+						}
+					}
+
+					// Run
+					api.PrintResponse(exportValidatorKeys(c, pubkeys))
+					return nil
+
+				},
+			},
+
+			{
+				Name:      "peek-validator-keys",
+				Usage:     "Get the pubkeys and indices of the next validator keys the wallet will generate (up to 1000), without generating them",
+				UsageText: "rocketpool api wallet peek-validator-keys count",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+					count, err := cliutils.ValidatePositiveUint("count", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(peekValidatorKeys(c, uint(count)))
+					return nil
+
+				},
+			},
+
+			{
+				Name:      "ens-profile",
+				Usage:     "Get the ENS name and common text records of the node address",
+				UsageText: "rocketpool api wallet ens-profile",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(getEnsProfile(c))
+					return nil
+
+				},
+			},
+
 			{
 				Name:      "set-password",
 				Aliases:   []string{"p"},
@@ -63,10 +153,18 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 				Usage:     "Initialize the node wallet",
 				UsageText: "rocketpool api wallet init",
 				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "mnemonic-passphrase",
+						Usage: "The optional BIP-39 passphrase (sometimes called the 25th word) used with the wallet's mnemonic",
+					},
 					cli.StringFlag{
 						Name:  "derivation-path, d",
 						Usage: "Specify the derivation path for the wallet.\nOmit this flag (or leave it blank) for the default of \"m/44'/60'/0'/0/%d\" (where %d is the index).\nSet this to \"ledgerLive\" to use Ledger Live's path of \"m/44'/60'/%d/0/0\".\nSet this to \"mew\" to use MyEtherWallet's path of \"m/44'/60'/0'/%d\".\nFor custom paths, simply enter them here.",
 					},
+					cli.IntFlag{
+						Name:  "entropy-bits, e",
+						Usage: "The number of bits of entropy to use for the wallet's mnemonic: 256 for a 24-word mnemonic (the default) or 128 for a 12-word mnemonic.",
+					},
 				},
 				Action: func(c *cli.Context) error {
 
@@ -88,6 +186,10 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 				Usage:     "Recover a node wallet from a mnemonic phrase",
 				UsageText: "rocketpool api wallet recover mnemonic",
 				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "mnemonic-passphrase",
+						Usage: "The optional BIP-39 passphrase (sometimes called the 25th word) used with the wallet's mnemonic",
+					},
 					cli.BoolFlag{
 						Name:  "skip-validator-key-recovery, k",
 						Usage: "Recover the node wallet, but do not regenerate its validator keys",
@@ -126,6 +228,14 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 				Usage:     "Search for and recover a node wallet's derivation key and index using a mnemonic phrase and a well-known address.",
 				UsageText: "rocketpool api wallet search-and-recover mnemonic address",
 				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "derivation-path",
+						Usage: "An additional derivation path to search, in the form custom:<path> where <path> contains a single %d for the index",
+					},
+					cli.StringFlag{
+						Name:  "mnemonic-passphrase",
+						Usage: "The optional BIP-39 passphrase (sometimes called the 25th word) used with the wallet's mnemonic",
+					},
 					cli.BoolFlag{
 						Name:  "skip-validator-key-recovery, k",
 						Usage: "Recover the node wallet, but do not regenerate its validator keys",
@@ -178,6 +288,10 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 				Usage:     "Test recovery of a node wallet and its validator keys without actually saving the recovered files",
 				UsageText: "rocketpool api wallet test-recovery mnemonic",
 				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "mnemonic-passphrase",
+						Usage: "The optional BIP-39 passphrase (sometimes called the 25th word) used with the wallet's mnemonic",
+					},
 					cli.BoolFlag{
 						Name:  "skip-validator-key-recovery, k",
 						Usage: "Recover the node wallet, but do not regenerate its validator keys",
@@ -216,6 +330,14 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 				Usage:     "Test searching for and recovery of a node wallet's derivation key, index, and validator keys using a mnemonic phrase and a well-known address.",
 				UsageText: "rocketpool api wallet test-search-and-recover mnemonic address",
 				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "derivation-path",
+						Usage: "An additional derivation path to search, in the form custom:<path> where <path> contains a single %d for the index",
+					},
+					cli.StringFlag{
+						Name:  "mnemonic-passphrase",
+						Usage: "The optional BIP-39 passphrase (sometimes called the 25th word) used with the wallet's mnemonic",
+					},
 					cli.BoolFlag{
 						Name:  "skip-validator-key-recovery, k",
 						Usage: "Recover the node wallet, but do not regenerate its validator keys",
@@ -243,6 +365,58 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 				},
 			},
 
+			{
+				Name:      "export-backup",
+				Usage:     "Export the node wallet, its password, and optionally its validator keys as a single encrypted backup",
+				UsageText: "rocketpool api wallet export-backup passphrase",
+				Flags: []cli.Flag{
+					cli.BoolFlag{
+						Name:  "include-validator-keys, v",
+						Usage: "Include the validator keystores in the backup",
+					},
+				},
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+					passphrase, err := cliutils.ValidateNodePassword("backup passphrase", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(exportBackup(c, passphrase))
+					return nil
+
+				},
+			},
+
+			{
+				Name:      "import-backup",
+				Usage:     "Restore the node wallet from an encrypted backup file",
+				UsageText: "rocketpool api wallet import-backup [options] backup-path passphrase",
+				Flags: []cli.Flag{
+					cli.BoolFlag{
+						Name:  "force, f",
+						Usage: "Overwrite an existing password file and validator key files",
+					},
+				},
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 2); err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(importBackup(c, c.Args().Get(0), c.Args().Get(1)))
+					return nil
+
+				},
+			},
+
 			{
 				Name:      "export",
 				Aliases:   []string{"e"},
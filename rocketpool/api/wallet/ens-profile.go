@@ -0,0 +1,146 @@
+package wallet
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/rocket-pool/rocketpool-go/rocketpool"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/contracts"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+// The text records included in the node's ENS profile
+var ensTextRecordKeys = []string{"avatar", "url", "description", "email", "com.twitter", "com.github", "org.telegram"}
+
+func getEnsProfile(c *cli.Context) (*api.WalletEnsProfileResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeWallet(c); err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	ec, err := services.GetEthClient(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.WalletEnsProfileResponse{
+		TextRecords: map[string]string{},
+	}
+
+	// Get the node account
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+	response.Address = nodeAccount.Address
+
+	// Get the ENS registry
+	registryAbi, err := abi.JSON(strings.NewReader(contracts.EnsRegistryABI))
+	if err != nil {
+		return nil, err
+	}
+	registryAddress := common.HexToAddress(contracts.EnsRegistryAddress)
+	registry := &rocketpool.Contract{
+		Contract: bind.NewBoundContract(registryAddress, registryAbi, ec, ec, ec),
+		Address:  &registryAddress,
+		ABI:      &registryAbi,
+		Client:   ec,
+	}
+	resolverAbi, err := abi.JSON(strings.NewReader(contracts.EnsResolverABI))
+	if err != nil {
+		return nil, err
+	}
+
+	// Reverse resolve the node address
+	reverseNode := ensNamehash(fmt.Sprintf("%s.addr.reverse", strings.ToLower(strings.TrimPrefix(nodeAccount.Address.Hex(), "0x"))))
+	reverseResolver, err := getEnsResolver(registry, ec, resolverAbi, reverseNode)
+	if err != nil {
+		return nil, err
+	}
+	if reverseResolver == nil {
+		return &response, nil
+	}
+	name := new(string)
+	if err := reverseResolver.Call(nil, name, "name", [32]byte(reverseNode)); err != nil {
+		return nil, fmt.Errorf("Could not get the ENS name for %s: %w", nodeAccount.Address.Hex(), err)
+	}
+	if *name == "" {
+		return &response, nil
+	}
+
+	// Make sure the name resolves back to the node address, otherwise anyone could claim it
+	nameNode := ensNamehash(*name)
+	resolver, err := getEnsResolver(registry, ec, resolverAbi, nameNode)
+	if err != nil {
+		return nil, err
+	}
+	if resolver == nil {
+		return &response, nil
+	}
+	forwardAddress := new(common.Address)
+	if err := resolver.Call(nil, forwardAddress, "addr", [32]byte(nameNode)); err != nil {
+		return nil, fmt.Errorf("Could not resolve ENS name %s: %w", *name, err)
+	}
+	if *forwardAddress != nodeAccount.Address {
+		return &response, nil
+	}
+	response.HasName = true
+	response.Name = *name
+
+	// Get the text records; resolvers that don't support text records just leave them empty
+	for _, key := range ensTextRecordKeys {
+		value := new(string)
+		if err := resolver.Call(nil, value, "text", [32]byte(nameNode), key); err != nil {
+			continue
+		}
+		if *value != "" {
+			response.TextRecords[key] = *value
+		}
+	}
+
+	// Return response
+	return &response, nil
+
+}
+
+// Get the resolver for an ENS node, or nil if it doesn't have one
+func getEnsResolver(registry *rocketpool.Contract, ec rocketpool.ExecutionClient, resolverAbi abi.ABI, node common.Hash) (*rocketpool.Contract, error) {
+	resolverAddress := new(common.Address)
+	if err := registry.Call(nil, resolverAddress, "resolver", [32]byte(node)); err != nil {
+		return nil, fmt.Errorf("Could not get ENS resolver: %w", err)
+	}
+	if *resolverAddress == (common.Address{}) {
+		return nil, nil
+	}
+	return &rocketpool.Contract{
+		Contract: bind.NewBoundContract(*resolverAddress, resolverAbi, ec, ec, ec),
+		Address:  resolverAddress,
+		ABI:      &resolverAbi,
+		Client:   ec,
+	}, nil
+}
+
+// Get the ENS namehash of a name, as defined in EIP-137
+func ensNamehash(name string) common.Hash {
+	node := common.Hash{}
+	if name == "" {
+		return node
+	}
+	labels := strings.Split(strings.ToLower(name), ".")
+	for i := len(labels) - 1; i >= 0; i-- {
+		node = crypto.Keccak256Hash(node.Bytes(), crypto.Keccak256([]byte(labels[i])))
+	}
+	return node
+}
@@ -0,0 +1,83 @@
+package wallet
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/rocket-pool/rocketpool-go/minipool"
+	"github.com/rocket-pool/rocketpool-go/types"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+	"github.com/rocket-pool/smartnode/shared/utils/log"
+)
+
+// Export validator keys as EIP-2335 keystores; if no pubkeys are provided, the keys for all of the node's validating minipools are exported.
+// This hands out the validator keys themselves, so every export is recorded in an audit log in the data folder.
+func exportValidatorKeys(c *cli.Context, pubkeys []types.ValidatorPubkey) (*api.ExportValidatorKeysResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeWallet(c); err != nil {
+		return nil, err
+	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.ExportValidatorKeysResponse{}
+
+	// Get the node's validator pubkeys if none were provided
+	if len(pubkeys) == 0 {
+		if err := services.RequireRocketStorage(c); err != nil {
+			return nil, err
+		}
+		rp, err := services.GetRocketPool(c)
+		if err != nil {
+			return nil, err
+		}
+		nodeAccount, err := w.GetNodeAccount()
+		if err != nil {
+			return nil, err
+		}
+		pubkeys, err = minipool.GetNodeValidatingMinipoolPubkeys(rp, nodeAccount.Address, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Export the keys
+	response.Keys = make([]api.ExportedValidatorKey, len(pubkeys))
+	for i, pubkey := range pubkeys {
+		keystore, password, err := w.ExportValidatorKeystore(pubkey)
+		if err != nil {
+			return nil, fmt.Errorf("Could not export the key for validator %s: %w", pubkey.Hex(), err)
+		}
+		response.Keys[i] = api.ExportedValidatorKey{
+			Pubkey:   pubkey,
+			Keystore: string(keystore),
+			Password: password,
+		}
+	}
+
+	// Record the export before handing out the keys
+	pubkeyStrings := make([]string, len(pubkeys))
+	for i, pubkey := range pubkeys {
+		pubkeyStrings[i] = pubkey.Hex()
+	}
+	auditLog := log.NewFileLogger(cfg.Smartnode.GetValidatorKeyExportLogPath(), "Validator Key Export", "audit")
+	err = auditLog.Printlnf("Exported the validator keys for %s", strings.Join(pubkeyStrings, ", "))
+	if err != nil {
+		return nil, fmt.Errorf("Could not record the export in the audit log: %w", err)
+	}
+
+	// Return response
+	return &response, nil
+
+}
@@ -40,8 +40,14 @@ func initWallet(c *cli.Context) (*api.InitWalletResponse, error) {
 		path = wallet.MyEtherWalletNodeKeyPath
 	}
 
+	// Get the mnemonic strength
+	entropyBits := c.Int("entropy-bits")
+	if entropyBits == 0 {
+		entropyBits = wallet.EntropyBits
+	}
+
 	// Initialize wallet but don't save it
-	mnemonic, err := w.Initialize(path, 0)
+	mnemonic, err := w.Initialize(path, 0, entropyBits, c.String("mnemonic-passphrase"))
 	if err != nil {
 		return nil, err
 	}
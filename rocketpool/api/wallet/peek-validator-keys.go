@@ -0,0 +1,50 @@
+package wallet
+
+import (
+	"fmt"
+
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+// The maximum number of keys that can be peeked at once, since each one has to be derived
+const maxPeekValidatorKeys uint = 1000
+
+func peekValidatorKeys(c *cli.Context, count uint) (*api.PeekValidatorKeysResponse, error) {
+
+	// Check the count
+	if count > maxPeekValidatorKeys {
+		return nil, fmt.Errorf("count %d is too large; at most %d keys can be peeked at once", count, maxPeekValidatorKeys)
+	}
+
+	// Get services
+	if err := services.RequireNodeWallet(c); err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.PeekValidatorKeysResponse{}
+
+	// Get the upcoming keys
+	previews, err := w.PeekValidatorKeys(count)
+	if err != nil {
+		return nil, err
+	}
+	response.Keys = make([]api.PeekedValidatorKey, len(previews))
+	for i, preview := range previews {
+		response.Keys[i] = api.PeekedValidatorKey{
+			Index:  preview.Index,
+			Pubkey: preview.Pubkey,
+		}
+	}
+
+	// Return response
+	return &response, nil
+
+}
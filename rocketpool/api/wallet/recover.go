@@ -3,6 +3,7 @@ package wallet
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/rocket-pool/rocketpool-go/rocketpool"
@@ -15,7 +16,8 @@ import (
 )
 
 const (
-	findIterations uint = 100000
+	findIterations         uint   = 100000
+	customDerivationPrefix string = "custom:"
 )
 
 func recoverWallet(c *cli.Context, mnemonic string) (*api.RecoverWalletResponse, error) {
@@ -62,7 +64,7 @@ func recoverWallet(c *cli.Context, mnemonic string) (*api.RecoverWalletResponse,
 	walletIndex := c.Uint("wallet-index")
 
 	// Recover wallet
-	if err := w.Recover(path, walletIndex, mnemonic); err != nil {
+	if err := w.Recover(path, walletIndex, mnemonic, c.String("mnemonic-passphrase")); err != nil {
 		return nil, err
 	}
 
@@ -120,10 +122,9 @@ func searchAndRecoverWallet(c *cli.Context, mnemonic string, address common.Addr
 	}
 
 	// Try each derivation path across all of the iterations
-	paths := []string{
-		wallet.DefaultNodeKeyPath,
-		wallet.LedgerLiveNodeKeyPath,
-		wallet.MyEtherWalletNodeKeyPath,
+	paths, err := getSearchDerivationPaths(c.String("derivation-path"))
+	if err != nil {
+		return nil, err
 	}
 	for i := uint(0); i < findIterations; i++ {
 		for j := 0; j < len(paths); j++ {
@@ -132,7 +133,7 @@ func searchAndRecoverWallet(c *cli.Context, mnemonic string, address common.Addr
 			if err != nil {
 				return nil, fmt.Errorf("error generating new wallet: %w", err)
 			}
-			err = recoveredWallet.TestRecovery(derivationPath, i, mnemonic)
+			err = recoveredWallet.TestRecovery(derivationPath, i, mnemonic, c.String("mnemonic-passphrase"))
 			if err != nil {
 				return nil, fmt.Errorf("error recovering wallet with path [%s], index [%d]: %w", derivationPath, i, err)
 			}
@@ -160,7 +161,7 @@ func searchAndRecoverWallet(c *cli.Context, mnemonic string, address common.Addr
 	}
 
 	// Recover wallet
-	if err := w.Recover(response.DerivationPath, response.Index, mnemonic); err != nil {
+	if err := w.Recover(response.DerivationPath, response.Index, mnemonic, c.String("mnemonic-passphrase")); err != nil {
 		return nil, err
 	}
 
@@ -187,3 +188,29 @@ func searchAndRecoverWallet(c *cli.Context, mnemonic string, address common.Addr
 	return &response, nil
 
 }
+
+// Get the derivation paths to search, including an optional custom path in the form "custom:<path>"
+func getSearchDerivationPaths(derivationPath string) ([]string, error) {
+	paths := []string{
+		wallet.DefaultNodeKeyPath,
+		wallet.LedgerLiveNodeKeyPath,
+		wallet.MyEtherWalletNodeKeyPath,
+	}
+	if derivationPath == "" {
+		return paths, nil
+	}
+
+	if !strings.HasPrefix(derivationPath, customDerivationPrefix) {
+		return nil, fmt.Errorf("invalid derivation path [%s]: custom search paths must be in the form %s<path>", derivationPath, customDerivationPrefix)
+	}
+	customPath := strings.TrimPrefix(derivationPath, customDerivationPrefix)
+	if strings.Count(customPath, "%d") != 1 || strings.Count(customPath, "%") != 1 {
+		return nil, fmt.Errorf("invalid derivation path [%s]: it must contain exactly one %%d placeholder for the wallet index", customPath)
+	}
+	for _, path := range paths {
+		if path == customPath {
+			return paths, nil
+		}
+	}
+	return append(paths, customPath), nil
+}
@@ -1,6 +1,8 @@
 package wallet
 
 import (
+	"os"
+
 	"github.com/urfave/cli"
 
 	"github.com/rocket-pool/smartnode/shared/services"
@@ -42,3 +44,47 @@ func getStatus(c *cli.Context) (*api.WalletStatusResponse, error) {
 	return &response, nil
 
 }
+
+func getStatusDetails(c *cli.Context) (*api.WalletStatusDetailsResponse, error) {
+
+	// Get services
+	pm, err := services.GetPasswordManager(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.WalletStatusDetailsResponse{}
+	response.Wallet = getArtifactStatus(w.GetWalletPath())
+	response.Password = getArtifactStatus(pm.GetPasswordPath())
+
+	// Return response
+	return &response, nil
+
+}
+
+// Check whether a wallet file exists and can be read at the given path
+func getArtifactStatus(path string) api.WalletArtifactStatus {
+	status := api.WalletArtifactStatus{
+		Path: path,
+	}
+	_, err := os.Stat(path)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			status.ReadError = err.Error()
+		}
+		return status
+	}
+	status.Exists = true
+	file, err := os.Open(path)
+	if err != nil {
+		status.ReadError = err.Error()
+		return status
+	}
+	file.Close()
+	return status
+}
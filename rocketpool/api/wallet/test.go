@@ -56,7 +56,7 @@ func testRecoverWallet(c *cli.Context, mnemonic string) (*api.RecoverWalletRespo
 	walletIndex := c.Uint("wallet-index")
 
 	// Recover wallet
-	if err := w.TestRecovery(path, walletIndex, mnemonic); err != nil {
+	if err := w.TestRecovery(path, walletIndex, mnemonic, c.String("mnemonic-passphrase")); err != nil {
 		return nil, err
 	}
 
@@ -108,10 +108,9 @@ func testSearchAndRecoverWallet(c *cli.Context, mnemonic string, address common.
 	response := api.SearchAndRecoverWalletResponse{}
 
 	// Try each derivation path across all of the iterations
-	paths := []string{
-		wallet.DefaultNodeKeyPath,
-		wallet.LedgerLiveNodeKeyPath,
-		wallet.MyEtherWalletNodeKeyPath,
+	paths, err := getSearchDerivationPaths(c.String("derivation-path"))
+	if err != nil {
+		return nil, err
 	}
 	for i := uint(0); i < findIterations; i++ {
 		for j := 0; j < len(paths); j++ {
@@ -120,7 +119,7 @@ func testSearchAndRecoverWallet(c *cli.Context, mnemonic string, address common.
 			if err != nil {
 				return nil, fmt.Errorf("error generating new wallet: %w", err)
 			}
-			err = recoveredWallet.TestRecovery(derivationPath, i, mnemonic)
+			err = recoveredWallet.TestRecovery(derivationPath, i, mnemonic, c.String("mnemonic-passphrase"))
 			if err != nil {
 				return nil, fmt.Errorf("error recovering wallet with path [%s], index [%d]: %w", derivationPath, i, err)
 			}
@@ -148,7 +147,7 @@ func testSearchAndRecoverWallet(c *cli.Context, mnemonic string, address common.
 	}
 
 	// Recover wallet
-	if err := w.TestRecovery(response.DerivationPath, response.Index, mnemonic); err != nil {
+	if err := w.TestRecovery(response.DerivationPath, response.Index, mnemonic, c.String("mnemonic-passphrase")); err != nil {
 		return nil, err
 	}
 
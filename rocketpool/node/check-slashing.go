@@ -0,0 +1,170 @@
+package node
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/docker/docker/client"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/rocketpool-go/minipool"
+	"github.com/rocket-pool/rocketpool-go/rocketpool"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	"github.com/rocket-pool/smartnode/shared/services/wallet"
+	"github.com/rocket-pool/smartnode/shared/utils/log"
+	rputils "github.com/rocket-pool/smartnode/shared/utils/rp"
+	"github.com/rocket-pool/smartnode/shared/utils/validator"
+)
+
+// The payload sent to the webhook when a validator is slashed
+type slashingAlert struct {
+	Priority         string         `json:"priority"`
+	Event            string         `json:"event"`
+	Minipool         common.Address `json:"minipool"`
+	Pubkey           string         `json:"pubkey"`
+	Epoch            uint64         `json:"epoch"`
+	ValidatorStopped bool           `json:"validatorStopped"`
+}
+
+// Check slashing task
+type checkSlashing struct {
+	c        *cli.Context
+	log      log.ColorLogger
+	cfg      *config.RocketPoolConfig
+	w        *wallet.Wallet
+	rp       *rocketpool.RocketPool
+	d        *client.Client
+	bc       beacon.Client
+	interval time.Duration
+	alerted  map[common.Address]bool
+
+	// Whether the validator client has already been stopped for a slashing
+	validatorStopped bool
+}
+
+// Create check slashing task
+func newCheckSlashing(c *cli.Context, logger log.ColorLogger) (*checkSlashing, error) {
+
+	// Get services
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+	d, err := services.GetDocker(c)
+	if err != nil {
+		return nil, err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the check interval; 0 means slashing alerts are disabled
+	var interval time.Duration
+	intervalString, _ := cfg.Smartnode.SlashingAlertInterval.Value.(string)
+	if intervalString != "" {
+		interval, err = time.ParseDuration(intervalString)
+		if err != nil {
+			return nil, fmt.Errorf("invalid slashing alert interval [%s]: %w", intervalString, err)
+		}
+	}
+
+	// Return task
+	return &checkSlashing{
+		c:        c,
+		log:      logger,
+		cfg:      cfg,
+		w:        w,
+		rp:       rp,
+		d:        d,
+		bc:       bc,
+		interval: interval,
+		alerted:  map[common.Address]bool{},
+	}, nil
+
+}
+
+// Check the node's validators for slashings
+func (t *checkSlashing) run() error {
+
+	// Get node account
+	nodeAccount, err := t.w.GetNodeAccount()
+	if err != nil {
+		return err
+	}
+
+	// Get the node's minipool validators
+	addresses, err := minipool.GetNodeMinipoolAddresses(t.rp, nodeAccount.Address, nil)
+	if err != nil {
+		return err
+	}
+	validators, err := rputils.GetMinipoolValidators(t.rp, t.bc, addresses, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	// Get the validators that were slashed since the last alert
+	slashed := []common.Address{}
+	for _, address := range addresses {
+		if validators[address].Slashed && !t.alerted[address] {
+			slashed = append(slashed, address)
+		}
+	}
+	if len(slashed) == 0 {
+		return nil
+	}
+
+	// Get the current epoch
+	head, err := t.bc.GetBeaconHead()
+	if err != nil {
+		return err
+	}
+
+	// Stop the validator client before notifying, since every epoch counts; this is only done once, even if the alerts have to be retried
+	if t.cfg.Smartnode.SlashingAlertStopValidator.Value == true && !t.validatorStopped {
+		t.log.Println("Stopping the validator client to prevent further penalties...")
+		if err := validator.StopValidator(t.cfg, t.bc, &t.log, t.d); err != nil {
+			t.log.Printlnf("WARNING: Could not stop the validator client: %s", err.Error())
+		} else {
+			t.validatorStopped = true
+		}
+	}
+
+	// Alert for each slashed validator
+	webhookUrl, _ := t.cfg.Smartnode.SlashingAlertWebhookUrl.Value.(string)
+	for _, address := range slashed {
+		alert := slashingAlert{
+			Priority:         "high",
+			Event:            "slashed",
+			Minipool:         address,
+			Pubkey:           validators[address].Pubkey.Hex(),
+			Epoch:            head.Epoch,
+			ValidatorStopped: t.validatorStopped,
+		}
+		t.log.Printlnf("ALERT: Validator %s (minipool %s) has been slashed!", alert.Pubkey, address.Hex())
+
+		// Only mark the validator as alerted once the webhook has been notified, so failed notifications are retried
+		if webhookUrl != "" {
+			if err := postWebhook(webhookUrl, alert); err != nil {
+				t.log.Printlnf("Error notifying the slashing alert webhook: %s", err.Error())
+				continue
+			}
+		}
+		t.alerted[address] = true
+	}
+
+	// Return
+	return nil
+
+}
@@ -230,7 +230,7 @@ func (collector *NodeCollector) Collect(channel chan<- prometheus.Metric) {
 		}*/
 
 		// Get the claimed and unclaimed intervals
-		unclaimed, claimed, err := rprewards.GetClaimStatus(collector.rp, collector.nodeAddress)
+		unclaimed, claimed, err := rprewards.GetClaimStatus(collector.rp, collector.nodeAddress, nil)
 		if err != nil {
 			return err
 		}
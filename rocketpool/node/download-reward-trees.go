@@ -91,7 +91,7 @@ func (d *downloadRewardsTrees) run() error {
 	}
 
 	// Get the unclaimed intervals
-	unclaimed, _, err := rprewards.GetClaimStatus(d.rp, nodeAccount.Address)
+	unclaimed, _, err := rprewards.GetClaimStatus(d.rp, nodeAccount.Address, nil)
 	if err != nil {
 		return err
 	}
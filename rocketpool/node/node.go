@@ -33,6 +33,8 @@ const (
 	DownloadRewardsTreesColor    = color.FgGreen
 	MetricsColor                 = color.FgHiYellow
 	ManageFeeRecipientColor      = color.FgHiCyan
+	WatchValidatorStatesColor    = color.FgHiMagenta
+	CheckSlashingColor           = color.FgHiRed
 	ErrorColor                   = color.FgRed
 	WarningColor                 = color.FgYellow
 )
@@ -64,6 +66,15 @@ func run(c *cli.Context) error {
 		return err
 	}
 
+	// Generate the per-validator graffiti file for the validator client; a bad map shouldn't stop the daemon
+	err = deployValidatorGraffitiFile(c)
+	if err != nil {
+		log.NewColorLogger(WarningColor).Printlnf("WARNING: Couldn't generate the validator graffiti file: %s", err.Error())
+	}
+
+	// Warn about validator keys that would be loaded more than once
+	checkForDuplicateValidatorKeys(c, log.NewColorLogger(WarningColor))
+
 	// Configure
 	configureHTTP()
 
@@ -85,6 +96,14 @@ func run(c *cli.Context) error {
 	if err != nil {
 		return err
 	}
+	watchValidatorStates, err := newWatchValidatorStates(c, log.NewColorLogger(WatchValidatorStatesColor))
+	if err != nil {
+		return err
+	}
+	checkSlashing, err := newCheckSlashing(c, log.NewColorLogger(CheckSlashingColor))
+	if err != nil {
+		return err
+	}
 
 	// Initialize loggers
 	errorLog := log.NewColorLogger(ErrorColor)
@@ -93,9 +112,14 @@ func run(c *cli.Context) error {
 	wg := new(sync.WaitGroup)
 	wg.Add(2)
 
-	// Run task loop
+	// Run task loop; the slashing check runs here too so only one loop refreshes the client status
 	go func() {
+		var lastTaskRun time.Time
+		var lastSlashingCheck time.Time
 		for {
+			tasksDue := time.Since(lastTaskRun) >= tasksInterval
+			slashingDue := checkSlashing.interval > 0 && time.Since(lastSlashingCheck) >= checkSlashing.interval
+
 			// Check the EC status
 			err := services.WaitEthClientSynced(c, false) // Force refresh the primary / fallback EC status
 			if err != nil {
@@ -106,25 +130,54 @@ func run(c *cli.Context) error {
 				if err != nil {
 					errorLog.Println(err)
 				} else {
-					// Manage the fee recipient for the node
-					if err := manageFeeRecipient.run(); err != nil {
-						errorLog.Println(err)
+					// Check for slashed validators
+					if slashingDue {
+						if err := checkSlashing.run(); err != nil {
+							errorLog.Println(err)
+						}
 					}
-					time.Sleep(taskCooldown)
 
-					// Run the rewards download check
-					if err := downloadRewardsTrees.run(); err != nil {
-						errorLog.Println(err)
+					if tasksDue {
+						// Manage the fee recipient for the node
+						if err := manageFeeRecipient.run(); err != nil {
+							errorLog.Println(err)
+						}
+						time.Sleep(taskCooldown)
+
+						// Run the rewards download check
+						if err := downloadRewardsTrees.run(); err != nil {
+							errorLog.Println(err)
+						}
+						time.Sleep(taskCooldown)
+
+						// Run the minipool stake check
+						if err := stakePrelaunchMinipools.run(); err != nil {
+							errorLog.Println(err)
+						}
+						time.Sleep(taskCooldown)
+
+						// Run the validator state check
+						if err := watchValidatorStates.run(); err != nil {
+							errorLog.Println(err)
+						}
 					}
-					time.Sleep(taskCooldown)
+				}
+			}
+			if slashingDue {
+				lastSlashingCheck = time.Now()
+			}
+			if tasksDue {
+				lastTaskRun = time.Now()
+			}
 
-					// Run the minipool stake check
-					if err := stakePrelaunchMinipools.run(); err != nil {
-						errorLog.Println(err)
-					}
+			// Wait until the tasks or the slashing check are due again
+			wait := time.Until(lastTaskRun.Add(tasksInterval))
+			if checkSlashing.interval > 0 {
+				if slashingWait := time.Until(lastSlashingCheck.Add(checkSlashing.interval)); slashingWait < wait {
+					wait = slashingWait
 				}
 			}
-			time.Sleep(tasksInterval)
+			time.Sleep(wait)
 		}
 		wg.Done()
 	}()
@@ -193,6 +246,48 @@ func deployDefaultFeeRecipientFile(c *cli.Context) error {
 
 }
 
+// Generate the validator client's graffiti file from the graffiti map, or remove it if there is no map
+func deployValidatorGraffitiFile(c *cli.Context) error {
+
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return err
+	}
+
+	consensusClient, _ := cfg.GetSelectedConsensusClient()
+	return config.WriteValidatorGraffitiFile(cfg.Smartnode.GetGraffitiMapPath(), cfg.Smartnode.GetValidatorGraffitiFilePath(), consensusClient)
+
+}
+
+// Log a prominent warning if any validator keystore contains more than one copy of a key
+func checkForDuplicateValidatorKeys(c *cli.Context, warningLog log.ColorLogger) {
+
+	w, err := services.GetWallet(c)
+	if err != nil {
+		warningLog.Printlnf("WARNING: could not check for duplicate validator keys: %s", err.Error())
+		return
+	}
+	duplicates, err := w.CheckForDuplicateValidatorKeys()
+	if err != nil {
+		warningLog.Printlnf("WARNING: could not check for duplicate validator keys: %s", err.Error())
+		return
+	}
+	if len(duplicates) == 0 {
+		return
+	}
+
+	warningLog.Println("=== WARNING: DUPLICATE VALIDATOR KEYS ===")
+	warningLog.Println("The following validator keys have more than one key file in the same keystore. Your validator client may load them more than once, which can get them SLASHED.")
+	for _, duplicate := range duplicates {
+		warningLog.Printlnf("%s keystore, validator 0x%s:", duplicate.Keystore, duplicate.Pubkey)
+		for _, path := range duplicate.Paths {
+			warningLog.Printlnf("\t%s", path)
+		}
+	}
+	warningLog.Println("Please remove the extra copies and restart your validator client.")
+
+}
+
 // Remove the old fee recipient files that were created in v1.5.0
 func removeLegacyFeeRecipientFiles(c *cli.Context) error {
 
@@ -137,7 +137,7 @@ func (t *stakePrelaunchMinipools) run() error {
 	}
 
 	// Get eth2 config
-	eth2Config, err := t.bc.GetEth2Config()
+	eth2Config, err := validator.GetDepositEth2Config(t.cfg, t.bc)
 	if err != nil {
 		return err
 	}
@@ -318,6 +318,13 @@ func (t *stakePrelaunchMinipools) stakeMinipool(mp *minipool.Minipool, eth2Confi
 		t.log.Println("NOTICE: The minipool has exceeded half of the timeout period, so it will be force-staked at the current gas price.")
 	}
 
+	// Check the node wallet's ETH reserve
+	if ok, err := api.CheckEthReserve(t.cfg, t.rp.Client, opts, gasInfo, maxFee, t.gasLimit, t.log); err != nil {
+		return false, err
+	} else if !ok {
+		return false, nil
+	}
+
 	opts.GasFeeCap = maxFee
 	opts.GasTipCap = t.maxPriorityFee
 	opts.GasLimit = gas.Uint64()
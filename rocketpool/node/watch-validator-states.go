@@ -0,0 +1,245 @@
+package node
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/rocketpool-go/minipool"
+	"github.com/rocket-pool/rocketpool-go/rocketpool"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	"github.com/rocket-pool/smartnode/shared/services/wallet"
+	"github.com/rocket-pool/smartnode/shared/utils/log"
+	rputils "github.com/rocket-pool/smartnode/shared/utils/rp"
+)
+
+// Settings
+const WebhookTimeout = 10 * time.Second
+
+// Validator states on the Beacon Chain
+const (
+	ValidatorState_NotDeposited = "not_deposited"
+	ValidatorState_Pending      = "pending"
+	ValidatorState_Active       = "active"
+	ValidatorState_Exiting      = "exiting"
+	ValidatorState_Exited       = "exited"
+	ValidatorState_Withdrawable = "withdrawable"
+)
+
+// A validator's last known state on the Beacon Chain
+type validatorState struct {
+	State   string `json:"state"`
+	Slashed bool   `json:"slashed"`
+}
+
+// The payload sent to the webhook when a validator's state changes
+type validatorStateChange struct {
+	Minipool        common.Address `json:"minipool"`
+	Pubkey          string         `json:"pubkey"`
+	Epoch           uint64         `json:"epoch"`
+	PreviousState   string         `json:"previousState"`
+	PreviousSlashed bool           `json:"previousSlashed"`
+	State           string         `json:"state"`
+	Slashed         bool           `json:"slashed"`
+}
+
+// Watch validator states task
+type watchValidatorStates struct {
+	c   *cli.Context
+	log log.ColorLogger
+	cfg *config.RocketPoolConfig
+	w   *wallet.Wallet
+	rp  *rocketpool.RocketPool
+	bc  beacon.Client
+}
+
+// Create watch validator states task
+func newWatchValidatorStates(c *cli.Context, logger log.ColorLogger) (*watchValidatorStates, error) {
+
+	// Get services
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return task
+	return &watchValidatorStates{
+		c:   c,
+		log: logger,
+		cfg: cfg,
+		w:   w,
+		rp:  rp,
+		bc:  bc,
+	}, nil
+
+}
+
+// Check the node's validators for state changes
+func (t *watchValidatorStates) run() error {
+
+	// Check if the webhook is enabled
+	webhookUrl, _ := t.cfg.Smartnode.ValidatorStateWebhookUrl.Value.(string)
+	if webhookUrl == "" {
+		return nil
+	}
+
+	// Log
+	t.log.Println("Checking for validator state changes...")
+
+	// Get node account
+	nodeAccount, err := t.w.GetNodeAccount()
+	if err != nil {
+		return err
+	}
+
+	// Get the node's minipool validators
+	addresses, err := minipool.GetNodeMinipoolAddresses(t.rp, nodeAccount.Address, nil)
+	if err != nil {
+		return err
+	}
+	validators, err := rputils.GetMinipoolValidators(t.rp, t.bc, addresses, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	// Get the current epoch
+	head, err := t.bc.GetBeaconHead()
+	if err != nil {
+		return err
+	}
+
+	// Load the previous states
+	statesPath := t.cfg.Smartnode.GetValidatorStatesPath()
+	previousStates, err := loadValidatorStates(statesPath)
+	if err != nil {
+		return err
+	}
+
+	// Check each validator for changes
+	currentStates := map[common.Address]validatorState{}
+	for _, address := range addresses {
+		validator := validators[address]
+		currentState := validatorState{
+			State:   getValidatorState(validator, head.Epoch),
+			Slashed: validator.Slashed,
+		}
+		currentStates[address] = currentState
+
+		// Ignore validators that haven't been seen before, so the first run doesn't notify for every validator
+		previousState, exists := previousStates[address]
+		if !exists || previousState == currentState {
+			continue
+		}
+
+		// Notify the webhook
+		change := validatorStateChange{
+			Minipool:        address,
+			Pubkey:          validator.Pubkey.Hex(),
+			Epoch:           head.Epoch,
+			PreviousState:   previousState.State,
+			PreviousSlashed: previousState.Slashed,
+			State:           currentState.State,
+			Slashed:         currentState.Slashed,
+		}
+		t.log.Printlnf("Validator %s (minipool %s) changed from %s to %s (slashed: %t).", change.Pubkey, address.Hex(), change.PreviousState, change.State, change.Slashed)
+		if err := postWebhook(webhookUrl, change); err != nil {
+			// Keep the previous state so the notification is retried on the next run
+			t.log.Printlnf("Error notifying the validator state webhook: %s", err.Error())
+			currentStates[address] = previousState
+		}
+	}
+
+	// Save the current states
+	return saveValidatorStates(statesPath, currentStates)
+
+}
+
+// Get a validator's state at the given epoch
+func getValidatorState(validator beacon.ValidatorStatus, epoch uint64) string {
+	switch {
+	case !validator.Exists:
+		return ValidatorState_NotDeposited
+	case validator.ActivationEpoch > epoch:
+		return ValidatorState_Pending
+	case validator.ExitEpoch == math.MaxUint64:
+		return ValidatorState_Active
+	case validator.ExitEpoch > epoch:
+		return ValidatorState_Exiting
+	case validator.WithdrawableEpoch > epoch:
+		return ValidatorState_Exited
+	default:
+		return ValidatorState_Withdrawable
+	}
+}
+
+// Load the last known validator states from disk
+func loadValidatorStates(path string) (map[common.Address]validatorState, error) {
+	states := map[common.Address]validatorState{}
+	bytes, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return states, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Could not read validator states from %s: %w", path, err)
+	}
+	if err := json.Unmarshal(bytes, &states); err != nil {
+		return nil, fmt.Errorf("Could not decode validator states from %s: %w", path, err)
+	}
+	return states, nil
+}
+
+// Save the last known validator states to disk
+func saveValidatorStates(path string, states map[common.Address]validatorState) error {
+	bytes, err := json.Marshal(states)
+	if err != nil {
+		return fmt.Errorf("Could not serialize validator states: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("Could not create validator states folder: %w", err)
+	}
+	if err := ioutil.WriteFile(path, bytes, 0644); err != nil {
+		return fmt.Errorf("Could not write validator states to %s: %w", path, err)
+	}
+	return nil
+}
+
+// Send a JSON payload to a webhook
+func postWebhook(url string, payload interface{}) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("Could not serialize webhook payload: %w", err)
+	}
+	client := http.Client{Timeout: WebhookTimeout}
+	response, err := client.Post(url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return fmt.Errorf("webhook returned status %s", response.Status)
+	}
+	return nil
+}
@@ -112,6 +112,14 @@ func main() {
 			Usage: "Port to serve metrics on if enabled",
 			Value: 9102,
 		},
+		cli.StringFlag{
+			Name:  "idempotency-key",
+			Usage: "An optional key for transaction-building API commands; retrying a command with the same key shortly afterwards returns the original response instead of building a new transaction",
+		},
+		cli.BoolFlag{
+			Name:  "finalized",
+			Usage: "Set this to true to run read-only API queries against the latest finalized Execution layer block instead of the chain head, so their results can't be changed by a reorg. Only the 'minipool status', 'minipool get-finalize-details' and 'node get-rewards-info' commands support this; the others return an error if it's set",
+		},
 		cli.BoolFlag{
 			Name:  "ignore-sync-check",
 			Usage: "Set this to true if you already checked the sync status of the execution client(s) and don't need to re-check it for this command",
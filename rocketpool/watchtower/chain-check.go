@@ -0,0 +1,55 @@
+package watchtower
+
+import (
+	"fmt"
+
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+)
+
+// Make sure the Beacon Node is on the same network as the Execution client before anything is submitted.
+// The networks can't change without a restart, so this only needs to be checked once at startup.
+func verifyChainMatch(c *cli.Context) error {
+
+	// Get services
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return err
+	}
+
+	// Get the deposit contract Rocket Pool uses
+	rpDepositContract, err := rp.GetContract("casperDeposit")
+	if err != nil {
+		return fmt.Errorf("Error getting Casper deposit contract: %w", err)
+	}
+	if rpDepositContract == nil {
+		return fmt.Errorf("Deposit contract was undefined.")
+	}
+
+	// Get the deposit contract the Beacon Node uses
+	eth2DepositContract, err := bc.GetEth2DepositContract()
+	if err != nil {
+		return fmt.Errorf("Error getting beacon client deposit contract: %w", err)
+	}
+
+	// Compare them
+	rpNetwork := uint64(cfg.Smartnode.GetChainID())
+	if rpNetwork != eth2DepositContract.ChainID || *rpDepositContract.Address != eth2DepositContract.Address {
+		return fmt.Errorf("Beacon network mismatch! Expected %s on chain %d, but beacon is using %s on chain %d.",
+			rpDepositContract.Address.Hex(),
+			rpNetwork,
+			eth2DepositContract.Address.Hex(),
+			eth2DepositContract.ChainID)
+	}
+	return nil
+
+}
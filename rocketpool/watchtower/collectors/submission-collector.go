@@ -0,0 +1,163 @@
+package collectors
+
+import (
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Task labels for the submission metrics
+const (
+	SubmissionTask_RplPrice    string = "rpl_price"
+	SubmissionTask_RewardsTree string = "rewards_tree"
+)
+
+// The submission counters for a single task
+type submissionStats struct {
+	attempted        float64
+	succeeded        float64
+	failed           float64
+	lastSuccessBlock float64
+	lastSuccessTime  float64
+}
+
+// Represents the collector for the watchtower's Oracle DAO submission metrics
+type SubmissionCollector struct {
+
+	// The number of submission transactions that were sent
+	attemptedDesc *prometheus.Desc
+
+	// The number of submission transactions that were included in a block successfully
+	succeededDesc *prometheus.Desc
+
+	// The number of submission transactions that failed to send or to be included
+	failedDesc *prometheus.Desc
+
+	// The block that the latest successful submission was for
+	lastSuccessBlockDesc *prometheus.Desc
+
+	// The time of the latest successful submission
+	lastSuccessTimeDesc *prometheus.Desc
+
+	// How long each rewards tree generation took
+	generationDuration *prometheus.HistogramVec
+
+	// Counters per task
+	stats map[string]*submissionStats
+
+	// Mutex
+	updateLock sync.Mutex
+}
+
+// Create a new SubmissionCollector instance
+func NewSubmissionCollector() *SubmissionCollector {
+	subsystem := "watchtower"
+	labels := []string{"task"}
+	return &SubmissionCollector{
+		attemptedDesc: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "submissions_attempted"),
+			"The number of submission transactions that were sent",
+			labels, nil,
+		),
+		succeededDesc: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "submissions_succeeded"),
+			"The number of submission transactions that were included in a block successfully",
+			labels, nil,
+		),
+		failedDesc: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "submissions_failed"),
+			"The number of submission transactions that failed to send or to be included",
+			labels, nil,
+		),
+		lastSuccessBlockDesc: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "last_success_block"),
+			"The block that the latest successful submission was for",
+			labels, nil,
+		),
+		lastSuccessTimeDesc: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "last_success_time"),
+			"The time of the latest successful submission",
+			labels, nil,
+		),
+		generationDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "generation_duration_seconds",
+			Help:      "How long each rewards tree generation took",
+			Buckets:   []float64{60, 300, 900, 1800, 3600, 7200, 14400, 28800},
+		}, labels),
+		stats: map[string]*submissionStats{
+			SubmissionTask_RplPrice:    {},
+			SubmissionTask_RewardsTree: {},
+		},
+	}
+}
+
+// Record that a submission transaction was sent
+func (collector *SubmissionCollector) RecordAttempt(task string) {
+	collector.updateLock.Lock()
+	defer collector.updateLock.Unlock()
+	collector.getStats(task).attempted++
+}
+
+// Record that a submission for the given block succeeded
+func (collector *SubmissionCollector) RecordSuccess(task string, blockNumber uint64) {
+	collector.updateLock.Lock()
+	defer collector.updateLock.Unlock()
+	stats := collector.getStats(task)
+	stats.succeeded++
+	stats.lastSuccessBlock = float64(blockNumber)
+	stats.lastSuccessTime = float64(time.Now().Unix())
+}
+
+// Record that a submission failed
+func (collector *SubmissionCollector) RecordFailure(task string) {
+	collector.updateLock.Lock()
+	defer collector.updateLock.Unlock()
+	collector.getStats(task).failed++
+}
+
+// Record how long a generation took
+func (collector *SubmissionCollector) ObserveGenerationDuration(task string, duration time.Duration) {
+	collector.generationDuration.WithLabelValues(task).Observe(duration.Seconds())
+}
+
+// Get the counters for a task, creating them if they don't exist yet; the lock must be held
+func (collector *SubmissionCollector) getStats(task string) *submissionStats {
+	stats, exists := collector.stats[task]
+	if !exists {
+		stats = &submissionStats{}
+		collector.stats[task] = stats
+	}
+	return stats
+}
+
+// Write metric descriptions to the Prometheus channel
+func (collector *SubmissionCollector) Describe(channel chan<- *prometheus.Desc) {
+	channel <- collector.attemptedDesc
+	channel <- collector.succeededDesc
+	channel <- collector.failedDesc
+	channel <- collector.lastSuccessBlockDesc
+	channel <- collector.lastSuccessTimeDesc
+	collector.generationDuration.Describe(channel)
+}
+
+// Collect the latest metric values and pass them to Prometheus
+func (collector *SubmissionCollector) Collect(channel chan<- prometheus.Metric) {
+
+	// Sync
+	collector.updateLock.Lock()
+	defer collector.updateLock.Unlock()
+
+	// Update all of the metrics
+	for task, stats := range collector.stats {
+		channel <- prometheus.MustNewConstMetric(
+			collector.attemptedDesc, prometheus.CounterValue, stats.attempted, task)
+		channel <- prometheus.MustNewConstMetric(
+			collector.succeededDesc, prometheus.CounterValue, stats.succeeded, task)
+		channel <- prometheus.MustNewConstMetric(
+			collector.failedDesc, prometheus.CounterValue, stats.failed, task)
+		channel <- prometheus.MustNewConstMetric(
+			collector.lastSuccessBlockDesc, prometheus.GaugeValue, stats.lastSuccessBlock, task)
+		channel <- prometheus.MustNewConstMetric(
+			collector.lastSuccessTimeDesc, prometheus.GaugeValue, stats.lastSuccessTime, task)
+	}
+	collector.generationDuration.Collect(channel)
+
+}
@@ -0,0 +1,204 @@
+package watchtower
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/rocket-pool/rocketpool-go/dao"
+	"github.com/rocket-pool/rocketpool-go/dao/trustednode"
+	"github.com/rocket-pool/rocketpool-go/rocketpool"
+	rptypes "github.com/rocket-pool/rocketpool-go/types"
+	"github.com/rocket-pool/rocketpool-go/utils/eth"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	rpgas "github.com/rocket-pool/smartnode/shared/services/gas"
+	"github.com/rocket-pool/smartnode/shared/services/wallet"
+	"github.com/rocket-pool/smartnode/shared/utils/api"
+	"github.com/rocket-pool/smartnode/shared/utils/log"
+)
+
+// Execute proposals task
+type executeProposals struct {
+	c   *cli.Context
+	log log.ColorLogger
+	cfg *config.RocketPoolConfig
+	w   *wallet.Wallet
+	rp  *rocketpool.RocketPool
+}
+
+// Create execute proposals task
+func newExecuteProposals(c *cli.Context, logger log.ColorLogger) (*executeProposals, error) {
+
+	// Get services
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return task
+	return &executeProposals{
+		c:   c,
+		log: logger,
+		cfg: cfg,
+		w:   w,
+		rp:  rp,
+	}, nil
+
+}
+
+// Execute proposals
+func (t *executeProposals) run() error {
+
+	// Check if auto-execution is enabled
+	if t.cfg.Smartnode.AutoExecuteOdaoProposals.Value != true {
+		return nil
+	}
+
+	// Wait for eth client to sync
+	if err := services.WaitEthClientSynced(t.c, true); err != nil {
+		return err
+	}
+
+	// Get node account
+	nodeAccount, err := t.w.GetNodeAccount()
+	if err != nil {
+		return err
+	}
+
+	// Check node trusted status
+	nodeTrusted, err := trustednode.GetMemberExists(t.rp, nodeAccount.Address, nil)
+	if err != nil {
+		return err
+	}
+	if !nodeTrusted {
+		return nil
+	}
+
+	// Log
+	t.log.Println("Checking for proposals to execute...")
+
+	// Get the proposals that have passed but haven't been executed
+	proposals, err := dao.GetDAOProposalsWithMember(t.rp, "rocketDAONodeTrustedProposals", nodeAccount.Address, nil)
+	if err != nil {
+		return err
+	}
+	succeededProposals := []dao.ProposalDetails{}
+	for _, proposal := range proposals {
+		if proposal.State == rptypes.Succeeded {
+			succeededProposals = append(succeededProposals, proposal)
+		}
+	}
+	if len(succeededProposals) == 0 {
+		return nil
+	}
+
+	// Find out which member index this node is
+	count, err := trustednode.GetMemberCount(t.rp, nil)
+	if err != nil {
+		return fmt.Errorf("Failed to get member count: %w", err)
+	}
+	var index = uint64(0)
+	for i := uint64(0); i < count; i++ {
+		addr, err := trustednode.GetMemberAt(t.rp, i, nil)
+		if err != nil {
+			return fmt.Errorf("Failed to get member at %d: %w", i, err)
+		}
+		if bytes.Equal(addr.Bytes(), nodeAccount.Address.Bytes()) {
+			index = i
+			break
+		}
+	}
+
+	// Get current block number
+	blockNumber, err := t.rp.Client.BlockNumber(context.Background())
+	if err != nil {
+		return fmt.Errorf("Failed to get block number: %w", err)
+	}
+	turn := blockNumber / BlocksPerTurn
+
+	// Execute the proposals this node is responsible for during the current turn.
+	// Responsibility rotates through the members each turn, starting at a different member for each proposal,
+	// so only one member attempts each proposal at a time and members that haven't enabled this are skipped over.
+	for _, proposal := range succeededProposals {
+		if (proposal.ID+turn)%count != index {
+			continue
+		}
+		if err := t.executeProposal(proposal); err != nil {
+			t.log.Printlnf("Error executing proposal %d: %s", proposal.ID, err.Error())
+		}
+	}
+
+	// Return
+	return nil
+
+}
+
+// Execute a proposal
+func (t *executeProposals) executeProposal(proposal dao.ProposalDetails) error {
+
+	// Log
+	t.log.Printlnf("Executing proposal %d (message: '%s', payload: %s)...", proposal.ID, proposal.Message, proposal.PayloadStr)
+
+	// Get transactor
+	opts, err := t.w.GetNodeAccountTransactor()
+	if err != nil {
+		return err
+	}
+
+	// Get the gas limit
+	gasInfo, err := trustednode.EstimateExecuteProposalGas(t.rp, proposal.ID, opts)
+	if err != nil {
+		return fmt.Errorf("Could not estimate the gas required to execute the proposal: %w", err)
+	}
+
+	// Get the current network max fee
+	maxFee, err := rpgas.GetHeadlessMaxFeeWei()
+	if err != nil {
+		return err
+	}
+
+	// Print the gas info, skipping execution while gas is above the watchtower's max fee
+	if !api.PrintAndCheckGasInfo(gasInfo, true, WatchtowerMaxFee, t.log, maxFee, 0) {
+		return nil
+	}
+
+	// Check the node wallet's ETH reserve
+	if ok, err := api.CheckEthReserve(t.cfg, t.rp.Client, opts, gasInfo, maxFee, 0, t.log); err != nil {
+		return err
+	} else if !ok {
+		return nil
+	}
+
+	// Set the gas settings
+	opts.GasFeeCap = maxFee
+	opts.GasTipCap = eth.GweiToWei(WatchtowerMaxPriorityFee)
+	opts.GasLimit = gasInfo.SafeGasLimit
+
+	// Execute the proposal
+	hash, err := trustednode.ExecuteProposal(t.rp, proposal.ID, opts)
+	if err != nil {
+		return err
+	}
+
+	// Print TX info and wait for it to be included in a block
+	err = api.PrintAndWaitForTransaction(t.cfg, hash, t.rp.Client, t.log)
+	if err != nil {
+		return err
+	}
+
+	// Log & return
+	t.log.Printlnf("Successfully executed proposal %d.", proposal.ID)
+	return nil
+
+}
@@ -12,17 +12,15 @@ import (
 	"sync"
 	"time"
 
-	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/crypto"
-	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/rocket-pool/rocketpool-go/rewards"
 	"github.com/rocket-pool/rocketpool-go/rocketpool"
 	"github.com/rocket-pool/smartnode/shared/services"
 	"github.com/rocket-pool/smartnode/shared/services/beacon"
 	"github.com/rocket-pool/smartnode/shared/services/config"
 	rprewards "github.com/rocket-pool/smartnode/shared/services/rewards"
+	"github.com/rocket-pool/smartnode/shared/utils/eth1"
 	"github.com/rocket-pool/smartnode/shared/utils/log"
 	"github.com/urfave/cli"
 )
@@ -106,8 +104,12 @@ func (t *generateRewardsTree) run() error {
 	for _, file := range files {
 		filename := file.Name()
 		if strings.HasSuffix(filename, config.RegenerateRewardsTreeRequestSuffix) && !file.IsDir() {
-			// Get the index
+			// Get the index and check if this is a dry run
+			dryRun := strings.HasSuffix(filename, config.DryRunRewardsTreeRequestSuffix)
 			indexString := strings.TrimSuffix(filename, config.RegenerateRewardsTreeRequestSuffix)
+			if dryRun {
+				indexString = strings.TrimSuffix(filename, config.DryRunRewardsTreeRequestSuffix)
+			}
 			index, err := strconv.ParseUint(indexString, 0, 64)
 			if err != nil {
 				return fmt.Errorf("Error parsing index from [%s]: %w", filename, err)
@@ -124,7 +126,7 @@ func (t *generateRewardsTree) run() error {
 			t.lock.Lock()
 			t.isRunning = true
 			t.lock.Unlock()
-			go t.generateRewardsTree(index)
+			go t.generateRewardsTree(index, dryRun)
 
 			// Return after the first request, do others at other intervals
 			return nil
@@ -134,9 +136,12 @@ func (t *generateRewardsTree) run() error {
 	return nil
 }
 
-func (t *generateRewardsTree) generateRewardsTree(index uint64) {
+func (t *generateRewardsTree) generateRewardsTree(index uint64, dryRun bool) {
 	// Begin generation of the tree
 	generationPrefix := fmt.Sprintf("[Interval %d Tree]", index)
+	if dryRun {
+		generationPrefix = fmt.Sprintf("[Interval %d Tree (Dry Run)]", index)
+	}
 	t.log.Printlnf("%s Starting generation of Merkle rewards tree for interval %d.", generationPrefix, index)
 
 	// Find the event for this interval
@@ -154,65 +159,43 @@ func (t *generateRewardsTree) generateRewardsTree(index uint64) {
 		return
 	}
 
-	// Try getting the rETH address as a canary to see if the block is available
-	client := t.rp
-	opts := &bind.CallOpts{
-		BlockNumber: elBlockHeader.Number,
-	}
-	address, err := client.RocketStorage.GetAddress(opts, crypto.Keccak256Hash([]byte("contract.addressrocketTokenRETH")))
+	// Get an EC that can serve the state for the snapshot block, falling back to the archive ECs if required
+	client, err := eth1.GetBestApiClient(t.rp, t.cfg, func(message string) {
+		t.log.Printlnf("%s %s", generationPrefix, message)
+	}, elBlockHeader.Number)
 	if err != nil {
-		errMessage := err.Error()
-		t.log.Printlnf("%s Error getting state for block %d: %s", generationPrefix, elBlockHeader.Number.Uint64(), errMessage)
-		if strings.Contains(errMessage, "missing trie node") || // Geth
-			strings.Contains(errMessage, "No state available for block") || // Nethermind
-			strings.Contains(errMessage, "Internal error") { // Besu
-
-			// The state was missing so fall back to the archive node
-			archiveEcUrl := t.cfg.Smartnode.ArchiveECUrl.Value.(string)
-			if archiveEcUrl != "" {
-				t.log.Printlnf("%s Primary EC cannot retrieve state for historical block %d, using archive EC [%s]", generationPrefix, elBlockHeader.Number.Uint64(), archiveEcUrl)
-				ec, err := ethclient.Dial(archiveEcUrl)
-				if err != nil {
-					t.handleError(fmt.Errorf("Error connecting to archive EC: %w", err))
-					return
-				}
-				client, err = rocketpool.NewRocketPool(ec, common.HexToAddress(t.cfg.Smartnode.GetStorageAddress()))
-				if err != nil {
-					t.handleError(fmt.Errorf("%s Error creating Rocket Pool client connected to archive EC: %w", err))
-					return
-				}
-
-				// Get the rETH address from the archive EC
-				address, err = client.RocketStorage.GetAddress(opts, crypto.Keccak256Hash([]byte("contract.addressrocketTokenRETH")))
-				if err != nil {
-					t.handleError(fmt.Errorf("%s Error verifying rETH address with Archive EC: %w", err))
-					return
-				}
-			} else {
-				// No archive node specified
-				t.handleError(fmt.Errorf("***ERROR*** Primary EC cannot retrieve state for historical block %d and the Archive EC is not specified.", elBlockHeader.Number.Uint64()))
-				return
-			}
-
-		}
-	}
-
-	// Sanity check the rETH address to make sure the client is working right
-	if address != t.cfg.Smartnode.GetRethAddress() {
-		t.handleError(fmt.Errorf("***ERROR*** Your Primary EC provided %s as the rETH address, but it should have been %s!", address.Hex(), t.cfg.Smartnode.GetRethAddress().Hex()))
+		t.handleError(fmt.Errorf("%s %w", generationPrefix, err))
 		return
 	}
 
 	// Generate the tree
-	t.generateRewardsTreeImpl(client, index, generationPrefix, rewardsEvent, elBlockHeader)
+	t.generateRewardsTreeImpl(client, index, generationPrefix, rewardsEvent, elBlockHeader, dryRun)
 }
 
 // Implementation for rewards tree generation using a viable EC
-func (t *generateRewardsTree) generateRewardsTreeImpl(rp *rocketpool.RocketPool, index uint64, generationPrefix string, rewardsEvent rewards.RewardsEvent, elBlockHeader *types.Header) {
+// Dry runs save the files to a new folder in the watchtower directory instead of replacing the interval's rewards files
+func (t *generateRewardsTree) generateRewardsTreeImpl(rp *rocketpool.RocketPool, index uint64, generationPrefix string, rewardsEvent rewards.RewardsEvent, elBlockHeader *types.Header, dryRun bool) {
 
 	// Generate the rewards file
 	start := time.Now()
 	rewardsFile := rprewards.NewRewardsFile(t.log, generationPrefix, index, rewardsEvent.IntervalStartTime, rewardsEvent.IntervalEndTime, rewardsEvent.ConsensusBlock.Uint64(), elBlockHeader, rewardsEvent.IntervalsPassed.Uint64())
+
+	// Log the progress of each stage in 10% increments so long generations can be told apart from stuck ones
+	lastReportedProgress := map[string]int{}
+	rewardsFile.SetProgressCallback(func(stage string, progress float64) {
+		percent := int(progress*10) * 10
+		if reported, exists := lastReportedProgress[stage]; exists && percent <= reported {
+			return
+		}
+		lastReportedProgress[stage] = percent
+		t.log.Printlnf("%s %s: %d%% complete (%s so far)", generationPrefix, stage, percent, time.Since(start))
+	})
+
+	// Dry runs don't checkpoint so they can't interfere with a real generation of the same interval
+	if !dryRun {
+		rewardsFile.SetCheckpointPath(t.cfg.Smartnode.GetRewardsTreeCheckpointPath(index, true), t.cfg.Smartnode.RewardsTreeCheckpointInterval.Value.(uint64))
+	}
+
 	err := rewardsFile.GenerateTree(rp, t.cfg, t.bc)
 	if err != nil {
 		t.handleError(fmt.Errorf("%s Error generating Merkle tree: %w", generationPrefix, err))
@@ -248,6 +231,15 @@ func (t *generateRewardsTree) generateRewardsTreeImpl(rp *rocketpool.RocketPool,
 	// Write the files
 	path := t.cfg.Smartnode.GetRewardsTreePath(index, true)
 	minipoolPerformancePath := t.cfg.Smartnode.GetMinipoolPerformancePath(index, true)
+	if dryRun {
+		dryRunDir, err := ioutil.TempDir(t.cfg.Smartnode.GetWatchtowerFolder(true), fmt.Sprintf(config.DryRunRewardsTreeFolderPattern, index))
+		if err != nil {
+			t.handleError(fmt.Errorf("%s Error creating dry run folder: %w", generationPrefix, err))
+			return
+		}
+		path = filepath.Join(dryRunDir, filepath.Base(path))
+		minipoolPerformancePath = filepath.Join(dryRunDir, filepath.Base(minipoolPerformancePath))
+	}
 	err = ioutil.WriteFile(minipoolPerformancePath, minipoolPerformanceBytes, 0644)
 	if err != nil {
 		t.handleError(fmt.Errorf("%s Error saving minipool performance file to %s: %w", generationPrefix, minipoolPerformancePath, err))
@@ -259,7 +251,11 @@ func (t *generateRewardsTree) generateRewardsTreeImpl(rp *rocketpool.RocketPool,
 		return
 	}
 
-	t.log.Printlnf("%s Merkle tree generation complete!", generationPrefix)
+	if dryRun {
+		t.log.Printlnf("%s Dry run complete! The computed Merkle root was %s; the files were saved to %s.", generationPrefix, root.Hex(), filepath.Dir(path))
+	} else {
+		t.log.Printlnf("%s Merkle tree generation complete!", generationPrefix)
+	}
 	t.lock.Lock()
 	t.isRunning = false
 	t.lock.Unlock()
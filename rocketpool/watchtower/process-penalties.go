@@ -512,6 +512,13 @@ func (t *processPenalties) submitPenalty(minipoolAddress common.Address, block *
 		return nil
 	}
 
+	// Check the node wallet's ETH reserve
+	if ok, err := api.CheckEthReserve(t.cfg, t.rp.Client, opts, gasInfo, maxFee, t.gasLimit, t.log); err != nil {
+		return err
+	} else if !ok {
+		return nil
+	}
+
 	opts.GasFeeCap = maxFee
 	opts.GasTipCap = t.maxPriorityFee
 	opts.GasLimit = gas.Uint64()
@@ -108,6 +108,13 @@ func (t *respondChallenges) run() error {
 		return nil
 	}
 
+	// Check the node wallet's ETH reserve
+	if ok, err := api.CheckEthReserve(t.cfg, t.rp.Client, opts, gasInfo, maxFee, 0, t.log); err != nil {
+		return err
+	} else if !ok {
+		return nil
+	}
+
 	// Set the gas settings
 	opts.GasFeeCap = maxFee
 	opts.GasTipCap = eth.GweiToWei(WatchtowerMaxPriorityFee)
@@ -0,0 +1,100 @@
+package watchtower
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/rocketpool-go/dao/trustednode"
+	"github.com/rocket-pool/rocketpool-go/rocketpool"
+
+	"github.com/rocket-pool/smartnode/shared/services/config"
+)
+
+// Schedules this node's turn to make consensus submissions, so lower-index Oracle DAO members submit first.
+// Tasks check it on each pass and skip the submission until their turn comes, instead of blocking the task loop while they wait.
+type submissionStagger struct {
+	rp  *rocketpool.RocketPool
+	cfg *config.RocketPoolConfig
+
+	// The submission that's currently scheduled, and the earliest time it can be made
+	key       string
+	notBefore time.Time
+
+	// Mutex
+	lock sync.Mutex
+}
+
+// Create a new submission stagger
+func newSubmissionStagger(rp *rocketpool.RocketPool, cfg *config.RocketPoolConfig) *submissionStagger {
+	return &submissionStagger{
+		rp:  rp,
+		cfg: cfg,
+	}
+}
+
+// Check whether it's this node's turn to make the submission with the given key.
+// The first time a submission is checked, its turn is scheduled based on the node's Oracle DAO member index; this returns false until that time has passed.
+// Callers should check whether consensus has already been reached before calling this on each pass.
+func (s *submissionStagger) isSubmissionTurn(key string, nodeAddress common.Address, printMessage func(string)) (bool, error) {
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	// Schedule the submission if it's new
+	if s.key != key {
+		delay, index, err := s.getSubmissionDelay(nodeAddress)
+		if err != nil {
+			return false, err
+		}
+		s.key = key
+		s.notBefore = time.Now().Add(delay)
+		if delay > 0 {
+			printMessage(fmt.Sprintf("Waiting %s for this node's turn to submit (Oracle DAO member index %d).", delay, index))
+			return false, nil
+		}
+		return true, nil
+	}
+
+	// Check if the turn has come
+	remaining := time.Until(s.notBefore)
+	if remaining > 0 {
+		printMessage(fmt.Sprintf("This node's turn to submit is in %s.", remaining.Round(time.Second)))
+		return false, nil
+	}
+	return true, nil
+
+}
+
+// Get how long this node should wait before submitting, based on its Oracle DAO member index
+func (s *submissionStagger) getSubmissionDelay(nodeAddress common.Address) (time.Duration, uint64, error) {
+
+	// Get the stagger
+	staggerString := s.cfg.Smartnode.OdaoSubmissionStagger.Value.(string)
+	stagger, err := time.ParseDuration(staggerString)
+	if err != nil {
+		return 0, 0, fmt.Errorf("Invalid submission stagger [%s]: %w", staggerString, err)
+	}
+	if stagger <= 0 {
+		return 0, 0, nil
+	}
+
+	// Find out which member index this node is
+	count, err := trustednode.GetMemberCount(s.rp, nil)
+	if err != nil {
+		return 0, 0, fmt.Errorf("Failed to get member count: %w", err)
+	}
+	for i := uint64(0); i < count; i++ {
+		addr, err := trustednode.GetMemberAt(s.rp, i, nil)
+		if err != nil {
+			return 0, 0, fmt.Errorf("Failed to get member at %d: %w", i, err)
+		}
+		if bytes.Equal(addr.Bytes(), nodeAddress.Bytes()) {
+			return stagger * time.Duration(i), i, nil
+		}
+	}
+	return 0, 0, nil
+
+}
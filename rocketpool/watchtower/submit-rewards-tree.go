@@ -9,6 +9,7 @@ import (
 	"math"
 	"math/big"
 	"os"
+	"path/filepath"
 	"sync"
 	"time"
 
@@ -20,6 +21,7 @@ import (
 	"github.com/rocket-pool/rocketpool-go/rewards"
 	"github.com/rocket-pool/rocketpool-go/rocketpool"
 	"github.com/rocket-pool/rocketpool-go/utils/eth"
+	"github.com/rocket-pool/smartnode/rocketpool/watchtower/collectors"
 	"github.com/rocket-pool/smartnode/shared/services"
 	"github.com/rocket-pool/smartnode/shared/services/beacon"
 	"github.com/rocket-pool/smartnode/shared/services/config"
@@ -31,7 +33,12 @@ import (
 	hexutil "github.com/rocket-pool/smartnode/shared/utils/hex"
 	"github.com/rocket-pool/smartnode/shared/utils/log"
 	"github.com/urfave/cli"
-	"github.com/web3-storage/go-w3s-client"
+)
+
+// Settings for retrying Beacon requests during snapshot selection, which shouldn't fail a submission on a momentary outage
+const (
+	SnapshotBeaconRequestAttempts   = 5
+	SnapshotBeaconRequestRetryDelay = 2 * time.Second
 )
 
 // Submit rewards Merkle Tree task
@@ -47,10 +54,17 @@ type submitRewardsTree struct {
 	lock             *sync.Mutex
 	isRunning        bool
 	generationPrefix string
+	stagger          *submissionStagger
+	uploader         TreeUploader
+
+	// The intervals that have already had a dry run, so they aren't generated again on every pass
+	dryRunIntervals map[uint64]bool
+
+	submissionCollector *collectors.SubmissionCollector
 }
 
 // Create submit rewards Merkle Tree task
-func newSubmitRewardsTree(c *cli.Context, logger log.ColorLogger, errorLogger log.ColorLogger) (*submitRewardsTree, error) {
+func newSubmitRewardsTree(c *cli.Context, logger log.ColorLogger, errorLogger log.ColorLogger, submissionCollector *collectors.SubmissionCollector) (*submitRewardsTree, error) {
 
 	// Get services
 	cfg, err := services.GetConfig(c)
@@ -87,6 +101,10 @@ func newSubmitRewardsTree(c *cli.Context, logger log.ColorLogger, errorLogger lo
 		lock:             lock,
 		isRunning:        false,
 		generationPrefix: "[Merkle Tree]",
+		stagger:          newSubmissionStagger(rp, cfg),
+		dryRunIntervals:  map[uint64]bool{},
+
+		submissionCollector: submissionCollector,
 	}
 
 	return generator, nil
@@ -185,6 +203,18 @@ func (t *submitRewardsTree) run() error {
 	minipoolPerformancePath := t.cfg.Smartnode.GetMinipoolPerformancePath(currentIndex, true)
 	compressedMinipoolPerformancePath := minipoolPerformancePath + config.RewardsTreeIpfsExtension
 
+	// Dry runs ignore any existing files and never upload or submit, so generate the tree once per interval
+	dryRun := t.cfg.Smartnode.RewardsTreeDryRun.Value.(bool)
+	if dryRun {
+		t.lock.Lock()
+		alreadyRun := t.dryRunIntervals[currentIndex]
+		t.lock.Unlock()
+		if !alreadyRun {
+			t.generateTree(intervalsPassed, nodeTrusted, currentIndex, snapshotBeaconBlock, elBlockIndex, startTime, endTime, snapshotElBlockHeader, rewardsTreePath, compressedRewardsTreePath, minipoolPerformancePath, compressedMinipoolPerformancePath, true)
+		}
+		return nil
+	}
+
 	// Check if we can reuse an existing file fir this interval
 	if t.isExistingFileValid(rewardsTreePath, uint64(intervalsPassed)) {
 		if !nodeTrusted {
@@ -209,16 +239,24 @@ func (t *submitRewardsTree) run() error {
 			return fmt.Errorf("Error reading rewards tree file: %w", err)
 		}
 
-		proofWrapper := new(rprewards.RewardsFile)
-		err = json.Unmarshal(wrapperBytes, proofWrapper)
+		proofWrapper, err := rprewards.DeserializeRewardsFile(wrapperBytes)
 		if err != nil {
 			return fmt.Errorf("Error deserializing rewards tree file: %w", err)
 		}
 
+		// Skip the submission until it's this node's turn; consensus is checked again on the next pass
+		isTurn, err := t.isSubmissionTurn(currentIndex)
+		if err != nil {
+			return err
+		}
+		if !isTurn {
+			return nil
+		}
+
 		// Upload the file
-		cid, err := t.uploadFileToWeb3Storage(wrapperBytes, compressedRewardsTreePath, "compressed rewards tree")
+		cid, err := t.uploadFile(wrapperBytes, compressedRewardsTreePath, "compressed rewards tree")
 		if err != nil {
-			return fmt.Errorf("Error uploading Merkle tree to Web3.Storage: %w", err)
+			return fmt.Errorf("Error uploading Merkle tree: %w", err)
 		}
 		t.log.Printlnf("Uploaded Merkle tree with CID %s", cid)
 
@@ -233,7 +271,7 @@ func (t *submitRewardsTree) run() error {
 	}
 
 	// Generate the tree
-	t.generateTree(intervalsPassed, nodeTrusted, currentIndex, snapshotBeaconBlock, elBlockIndex, startTime, endTime, snapshotElBlockHeader, rewardsTreePath, compressedRewardsTreePath, minipoolPerformancePath, compressedMinipoolPerformancePath)
+	t.generateTree(intervalsPassed, nodeTrusted, currentIndex, snapshotBeaconBlock, elBlockIndex, startTime, endTime, snapshotElBlockHeader, rewardsTreePath, compressedRewardsTreePath, minipoolPerformancePath, compressedMinipoolPerformancePath, false)
 
 	// Done
 	return nil
@@ -259,14 +297,13 @@ func (t *submitRewardsTree) isExistingFileValid(rewardsTreePath string, interval
 	_, err := os.Stat(rewardsTreePath)
 	if !os.IsNotExist(err) {
 		// The file already exists, attempt to read it
-		var proofWrapper rprewards.RewardsFile
 		fileBytes, err := ioutil.ReadFile(rewardsTreePath)
 		if err != nil {
 			t.log.Printlnf("WARNING: failed to read %s: %s\nRegenerating file...\n", rewardsTreePath, err.Error())
 			return false
 		}
 
-		err = json.Unmarshal(fileBytes, &proofWrapper)
+		proofWrapper, err := rprewards.DeserializeRewardsFile(fileBytes)
 		if err != nil {
 			t.log.Printlnf("WARNING: failed to deserialize %s: %s\nRegenerating file...\n", rewardsTreePath, err.Error())
 			return false
@@ -287,7 +324,7 @@ func (t *submitRewardsTree) isExistingFileValid(rewardsTreePath string, interval
 }
 
 // Kick off the tree generation goroutine
-func (t *submitRewardsTree) generateTree(intervalsPassed time.Duration, nodeTrusted bool, currentIndex uint64, snapshotBeaconBlock uint64, elBlockIndex uint64, startTime time.Time, endTime time.Time, snapshotElBlockHeader *types.Header, rewardsTreePath string, compressedRewardsTreePath string, minipoolPerformancePath string, compressedMinipoolPerformancePath string) {
+func (t *submitRewardsTree) generateTree(intervalsPassed time.Duration, nodeTrusted bool, currentIndex uint64, snapshotBeaconBlock uint64, elBlockIndex uint64, startTime time.Time, endTime time.Time, snapshotElBlockHeader *types.Header, rewardsTreePath string, compressedRewardsTreePath string, minipoolPerformancePath string, compressedMinipoolPerformancePath string, dryRun bool) {
 
 	go func() {
 		t.lock.Lock()
@@ -302,12 +339,15 @@ func (t *submitRewardsTree) generateTree(intervalsPassed time.Duration, nodeTrus
 		}
 
 		// Generate the tree
-		err = t.generateTreeImpl(client, intervalsPassed, nodeTrusted, currentIndex, snapshotBeaconBlock, elBlockIndex, startTime, endTime, snapshotElBlockHeader, rewardsTreePath, compressedRewardsTreePath, minipoolPerformancePath, compressedMinipoolPerformancePath)
+		err = t.generateTreeImpl(client, intervalsPassed, nodeTrusted, currentIndex, snapshotBeaconBlock, elBlockIndex, startTime, endTime, snapshotElBlockHeader, rewardsTreePath, compressedRewardsTreePath, minipoolPerformancePath, compressedMinipoolPerformancePath, dryRun)
 		if err != nil {
 			t.handleError(err)
 		}
 
 		t.lock.Lock()
+		if dryRun && err == nil {
+			t.dryRunIntervals[currentIndex] = true
+		}
 		t.isRunning = false
 		t.lock.Unlock()
 	}()
@@ -315,7 +355,8 @@ func (t *submitRewardsTree) generateTree(intervalsPassed time.Duration, nodeTrus
 }
 
 // Implementation for rewards tree generation using a viable EC
-func (t *submitRewardsTree) generateTreeImpl(rp *rocketpool.RocketPool, intervalsPassed time.Duration, nodeTrusted bool, currentIndex uint64, snapshotBeaconBlock uint64, elBlockIndex uint64, startTime time.Time, endTime time.Time, snapshotElBlockHeader *types.Header, rewardsTreePath string, compressedRewardsTreePath string, minipoolPerformancePath string, compressedMinipoolPerformancePath string) error {
+// Dry runs save the files to a new folder in the watchtower directory and skip the upload and submission
+func (t *submitRewardsTree) generateTreeImpl(rp *rocketpool.RocketPool, intervalsPassed time.Duration, nodeTrusted bool, currentIndex uint64, snapshotBeaconBlock uint64, elBlockIndex uint64, startTime time.Time, endTime time.Time, snapshotElBlockHeader *types.Header, rewardsTreePath string, compressedRewardsTreePath string, minipoolPerformancePath string, compressedMinipoolPerformancePath string, dryRun bool) error {
 
 	// Log
 	if uint64(intervalsPassed) > 1 {
@@ -323,12 +364,28 @@ func (t *submitRewardsTree) generateTreeImpl(rp *rocketpool.RocketPool, interval
 	}
 	t.log.Printlnf("Rewards checkpoint has passed, starting Merkle tree generation for interval %d in the background.\n%s Snapshot Beacon block = %d, EL block = %d, running from %s to %s", currentIndex, t.generationPrefix, snapshotBeaconBlock, elBlockIndex, startTime, endTime)
 
+	// Keep dry runs away from the interval's real files
+	checkpointPath := t.cfg.Smartnode.GetRewardsTreeCheckpointPath(currentIndex, true)
+	if dryRun {
+		dryRunDir, err := ioutil.TempDir(t.cfg.Smartnode.GetWatchtowerFolder(true), fmt.Sprintf(config.DryRunRewardsTreeFolderPattern, currentIndex))
+		if err != nil {
+			return fmt.Errorf("Error creating dry run folder: %w", err)
+		}
+		rewardsTreePath = filepath.Join(dryRunDir, filepath.Base(rewardsTreePath))
+		minipoolPerformancePath = filepath.Join(dryRunDir, filepath.Base(minipoolPerformancePath))
+		checkpointPath = filepath.Join(dryRunDir, filepath.Base(checkpointPath))
+		t.printMessage(fmt.Sprintf("Dry run enabled, saving the files to %s without uploading or submitting them.", dryRunDir))
+	}
+
 	// Generate the rewards file
 	rewardsFile := rprewards.NewRewardsFile(t.log, t.generationPrefix, currentIndex, startTime, endTime, snapshotBeaconBlock, snapshotElBlockHeader, uint64(intervalsPassed))
+	rewardsFile.SetCheckpointPath(checkpointPath, t.cfg.Smartnode.RewardsTreeCheckpointInterval.Value.(uint64))
+	generationStart := time.Now()
 	err := rewardsFile.GenerateTree(rp, t.cfg, t.bc)
 	if err != nil {
 		return fmt.Errorf("Error generating Merkle tree: %w", err)
 	}
+	t.submissionCollector.ObserveGenerationDuration(collectors.SubmissionTask_RewardsTree, time.Since(generationStart))
 	for address, network := range rewardsFile.InvalidNetworkNodes {
 		t.printMessage(fmt.Sprintf("WARNING: Node %s has invalid network %d assigned! Using 0 (mainnet) instead.", address.Hex(), network))
 	}
@@ -346,11 +403,11 @@ func (t *submitRewardsTree) generateTreeImpl(rp *rocketpool.RocketPool, interval
 	}
 
 	// Upload it if this is an Oracle DAO node
-	if nodeTrusted {
-		t.printMessage("Uploading minipool performance file to Web3.Storage...")
-		minipoolPerformanceCid, err := t.uploadFileToWeb3Storage(minipoolPerformanceBytes, compressedMinipoolPerformancePath, "compressed minipool performance")
+	if nodeTrusted && !dryRun {
+		t.printMessage("Uploading minipool performance file...")
+		minipoolPerformanceCid, err := t.uploadFile(minipoolPerformanceBytes, compressedMinipoolPerformancePath, "compressed minipool performance")
 		if err != nil {
-			return fmt.Errorf("Error uploading minipool performance file to Web3.Storage: %w", err)
+			return fmt.Errorf("Error uploading minipool performance file: %w", err)
 		}
 		t.printMessage(fmt.Sprintf("Uploaded minipool performance file with CID %s", minipoolPerformanceCid))
 		rewardsFile.MinipoolPerformanceFileCID = minipoolPerformanceCid
@@ -372,13 +429,29 @@ func (t *submitRewardsTree) generateTreeImpl(rp *rocketpool.RocketPool, interval
 		return fmt.Errorf("Error saving rewards tree file to %s: %w", rewardsTreePath, err)
 	}
 
+	// Dry runs stop here, before anything is uploaded or submitted
+	if dryRun {
+		root := common.BytesToHash(rewardsFile.MerkleTree.Root())
+		t.printMessage(fmt.Sprintf("Dry run complete! The computed Merkle root for interval %d was %s; the files were saved to %s.", currentIndex, root.Hex(), filepath.Dir(rewardsTreePath)))
+		return nil
+	}
+
 	// Only do the upload and submission process if this is an Oracle DAO node
 	if nodeTrusted {
+		// Leave the submission to a later pass if it isn't this node's turn yet; it will resubmit the saved file
+		isTurn, err := t.isSubmissionTurn(currentIndex)
+		if err != nil {
+			return err
+		}
+		if !isTurn {
+			return nil
+		}
+
 		// Upload the rewards tree file
-		t.printMessage("Uploading to Web3.Storage and submitting results to the contracts...")
-		cid, err := t.uploadFileToWeb3Storage(wrapperBytes, compressedRewardsTreePath, "compressed rewards tree")
+		t.printMessage("Uploading rewards files and submitting results to the contracts...")
+		cid, err := t.uploadFile(wrapperBytes, compressedRewardsTreePath, "compressed rewards tree")
 		if err != nil {
-			return fmt.Errorf("Error uploading Merkle tree to Web3.Storage: %w", err)
+			return fmt.Errorf("Error uploading Merkle tree: %w", err)
 		}
 		t.printMessage(fmt.Sprintf("Uploaded Merkle tree with CID %s", cid))
 
@@ -397,6 +470,15 @@ func (t *submitRewardsTree) generateTreeImpl(rp *rocketpool.RocketPool, interval
 
 }
 
+// Check if it's this node's turn to submit the tree for the given interval
+func (t *submitRewardsTree) isSubmissionTurn(index uint64) (bool, error) {
+	nodeAccount, err := t.w.GetNodeAccount()
+	if err != nil {
+		return false, err
+	}
+	return t.stagger.isSubmissionTurn(fmt.Sprint(index), nodeAccount.Address, t.printMessage)
+}
+
 // Submit rewards info to the contracts
 func (t *submitRewardsTree) submitRewardsSnapshot(index *big.Int, consensusBlock uint64, executionBlock uint64, rewardsFile *rprewards.RewardsFile, cid string, intervalsPassed *big.Int) error {
 
@@ -459,69 +541,87 @@ func (t *submitRewardsTree) submitRewardsSnapshot(index *big.Int, consensusBlock
 		return nil
 	}
 
+	// Check the node wallet's ETH reserve
+	if ok, err := api.CheckEthReserve(t.cfg, t.rp.Client, opts, gasInfo, maxFee, 0, t.log); err != nil {
+		return err
+	} else if !ok {
+		return nil
+	}
+
 	opts.GasFeeCap = maxFee
 	opts.GasTipCap = eth.GweiToWei(WatchtowerMaxPriorityFee)
 	opts.GasLimit = gasInfo.SafeGasLimit
 
 	// Submit RPL price
+	t.submissionCollector.RecordAttempt(collectors.SubmissionTask_RewardsTree)
 	hash, err := rewards.SubmitRewardSnapshot(t.rp, submission, opts)
 	if err != nil {
+		t.submissionCollector.RecordFailure(collectors.SubmissionTask_RewardsTree)
 		return err
 	}
 
 	// Print TX info and wait for it to be included in a block
 	err = api.PrintAndWaitForTransaction(t.cfg, hash, t.rp.Client, t.log)
 	if err != nil {
+		t.submissionCollector.RecordFailure(collectors.SubmissionTask_RewardsTree)
 		return err
 	}
 
 	// Return
+	t.submissionCollector.RecordSuccess(collectors.SubmissionTask_RewardsTree, executionBlock)
 	return nil
 }
 
-// Compress and upload a file to Web3.Storage and get the CID for it
-func (t *submitRewardsTree) uploadFileToWeb3Storage(wrapperBytes []byte, compressedPath string, description string) (string, error) {
-
-	// Get the API token
-	apiToken := t.cfg.Smartnode.Web3StorageApiToken.Value.(string)
-	if apiToken == "" {
-		return "", fmt.Errorf("***ERROR***\nYou have not configured your Web3.Storage API token yet, so you cannot submit Merkle rewards trees.\nPlease get an API token from https://web3.storage and enter it in the Smartnode section of the `service config` TUI (or use `--smartnode-web3StorageApiToken` if you configure your system headlessly).")
-	}
+// Compress and upload a file to IPFS and get the CID for it
+func (t *submitRewardsTree) uploadFile(wrapperBytes []byte, compressedPath string, description string) (string, error) {
 
-	// Create the client
-	w3sClient, err := w3s.NewClient(w3s.WithToken(apiToken))
-	if err != nil {
-		return "", fmt.Errorf("Error creating new Web3.Storage client: %w", err)
+	// Create the uploader once, so state like the IPFS node's CID check carries over between uploads
+	if t.uploader == nil {
+		uploader, err := getTreeUploader(t.cfg)
+		if err != nil {
+			return "", err
+		}
+		t.uploader = uploader
 	}
+	uploader := t.uploader
 
 	// Compress the file
 	encoder, _ := zstd.NewWriter(nil, zstd.WithEncoderLevel(zstd.SpeedBestCompression))
 	compressedBytes := encoder.EncodeAll(wrapperBytes, make([]byte, 0, len(wrapperBytes)))
 
-	// Create the compressed tree file
-	compressedFile, err := os.Create(compressedPath)
+	// Write the compressed tree file
+	err := ioutil.WriteFile(compressedPath, compressedBytes, 0644)
 	if err != nil {
-		return "", fmt.Errorf("Error creating %s file [%s]: %w", description, compressedPath, err)
+		return "", fmt.Errorf("Error writing %s to %s: %w", description, compressedPath, err)
 	}
-	defer compressedFile.Close()
 
-	// Write the compressed data to the file
-	_, err = compressedFile.Write(compressedBytes)
+	// Get the retry settings
+	attempts := t.cfg.Smartnode.TreeUploadAttempts.Value.(uint64)
+	if attempts == 0 {
+		attempts = 1
+	}
+	retryDelayString := t.cfg.Smartnode.TreeUploadRetryDelay.Value.(string)
+	retryDelay, err := time.ParseDuration(retryDelayString)
 	if err != nil {
-		return "", fmt.Errorf("Error writing %s to %s: %w", description, compressedPath, err)
+		return "", fmt.Errorf("Invalid upload retry delay [%s]: %w", retryDelayString, err)
 	}
 
-	// Rewind it to the start
-	compressedFile.Seek(0, 0)
+	// Upload it, retrying with an exponential backoff on failure
+	for attempt := uint64(1); ; attempt++ {
+		t.printMessage(fmt.Sprintf("Uploading %s to %s (attempt %d of %d)...", description, uploader.Name(), attempt, attempts))
+		cid, err := uploader.Upload(context.Background(), compressedPath)
+		if err == nil {
+			return cid, nil
+		}
+		if attempt >= attempts {
+			return "", fmt.Errorf("Error uploading %s after %d attempts: %w", description, attempts, err)
+		}
 
-	// Upload it
-	cid, err := w3sClient.Put(context.Background(), compressedFile)
-	if err != nil {
-		return "", fmt.Errorf("Error uploading %s: %w", description, err)
+		t.printMessage(fmt.Sprintf("Error uploading %s: %s; retrying in %s...", description, err.Error(), retryDelay))
+		time.Sleep(retryDelay)
+		retryDelay *= 2
 	}
 
-	return cid.String(), nil
-
 }
 
 // Get the first finalized, successful consensus block that occurred after the given target time
@@ -534,7 +634,12 @@ func (t *submitRewardsTree) getSnapshotConsensusBlock(endTime time.Time) (uint64
 	}
 
 	// Get the beacon head
-	beaconHead, err := t.bc.GetBeaconHead()
+	var beaconHead beacon.BeaconHead
+	err = t.retryBeaconRequest("Beacon head", func() error {
+		var err error
+		beaconHead, err = t.bc.GetBeaconHead()
+		return err
+	})
 	if err != nil {
 		return 0, 0, time.Time{}, fmt.Errorf("Error getting Beacon head: %w", err)
 	}
@@ -552,27 +657,68 @@ func (t *submitRewardsTree) getSnapshotConsensusBlock(endTime time.Time) (uint64
 		return 0, 0, time.Time{}, fmt.Errorf("Snapshot end time = %s, slot (epoch) = %d (%d)... waiting until epoch %d is finalized (currently %d).", endTime, targetSlot, targetSlotEpoch, requiredEpoch, beaconHead.FinalizedEpoch)
 	}
 
+	// Don't search more than one full epoch below the target slot, so a long run of missing slots can't select a block from the wrong epoch
+	lowestSlot := uint64(0)
+	if targetSlot > eth2Config.SlotsPerEpoch {
+		lowestSlot = targetSlot - eth2Config.SlotsPerEpoch
+	}
+
 	// Get the first successful block
+	originalTargetSlot := targetSlot
+	missingSlots := uint64(0)
 	for {
-		// Try to get the current block
-		block, exists, err := t.bc.GetBeaconBlock(fmt.Sprint(targetSlot))
+		// Try to get the current block; a missing block isn't an error, so only request failures are retried
+		var block beacon.BeaconBlock
+		var exists bool
+		err := t.retryBeaconRequest(fmt.Sprintf("Beacon block %d", targetSlot), func() error {
+			var err error
+			block, exists, err = t.bc.GetBeaconBlock(fmt.Sprint(targetSlot))
+			return err
+		})
 		if err != nil {
 			return 0, 0, time.Time{}, fmt.Errorf("Error getting Beacon block %d: %w", targetSlot, err)
 		}
 
-		// If the block was missing, try the previous one
-		if !exists {
-			t.log.Printlnf("Slot %d was missing, trying the previous one...", targetSlot)
-			targetSlot--
-		} else {
+		if exists {
 			// Ok, we have the first proposed finalized block - this is the one to use for the snapshot!
+			if missingSlots > 0 {
+				t.log.Printlnf("Skipped %d missing slots below slot %d, using slot %d for the snapshot.", missingSlots, originalTargetSlot, targetSlot)
+			}
 			blockTime := genesisTime.Add(time.Duration(requiredEpoch*eth2Config.SecondsPerEpoch) * time.Second)
 			return targetSlot, block.ExecutionBlockNumber, blockTime, nil
 		}
+
+		// The block was missing, so try the previous one; only the first miss is logged individually to keep the log readable
+		if missingSlots == 0 {
+			t.log.Printlnf("Slot %d was missing, trying previous slots...", targetSlot)
+		}
+		missingSlots++
+		if targetSlot <= lowestSlot {
+			return 0, 0, time.Time{}, fmt.Errorf("Could not find a proposed block for the snapshot: slots %d through %d were all missing, which is more than one full epoch (%d slots) below the target", targetSlot, originalTargetSlot, eth2Config.SlotsPerEpoch)
+		}
+		targetSlot--
 	}
 
 }
 
+// Run a Beacon request, retrying it with an exponential backoff if it fails
+func (t *submitRewardsTree) retryBeaconRequest(description string, request func() error) error {
+	retryDelay := SnapshotBeaconRequestRetryDelay
+	for attempt := 1; ; attempt++ {
+		err := request()
+		if err == nil {
+			return nil
+		}
+		if attempt >= SnapshotBeaconRequestAttempts {
+			return fmt.Errorf("failed after %d attempts: %w", SnapshotBeaconRequestAttempts, err)
+		}
+
+		t.log.Printlnf("Error getting %s: %s; retrying in %s...", description, err.Error(), retryDelay)
+		time.Sleep(retryDelay)
+		retryDelay *= 2
+	}
+}
+
 // Check whether the rewards tree for the current interval been submitted by the node
 func (t *submitRewardsTree) hasSubmittedTree(nodeAddress common.Address, index *big.Int) (bool, error) {
 	indexBuffer := make([]byte, 32)
@@ -23,6 +23,7 @@ import (
 	"github.com/urfave/cli"
 	"golang.org/x/sync/errgroup"
 
+	"github.com/rocket-pool/smartnode/rocketpool/watchtower/collectors"
 	"github.com/rocket-pool/smartnode/shared/services"
 	"github.com/rocket-pool/smartnode/shared/services/beacon"
 	"github.com/rocket-pool/smartnode/shared/services/config"
@@ -32,6 +33,7 @@ import (
 	"github.com/rocket-pool/smartnode/shared/utils/eth1"
 	"github.com/rocket-pool/smartnode/shared/utils/log"
 	mathutils "github.com/rocket-pool/smartnode/shared/utils/math"
+	rputils "github.com/rocket-pool/smartnode/shared/utils/rp"
 )
 
 const MessengerAbi = `[
@@ -60,6 +62,12 @@ const MessengerAbi = `[
 // Settings
 const BlocksPerTurn = 75 // Approx. 15 minutes
 
+// The approximate time between blocks, used to convert the L2 rate refresh interval into blocks
+const l2RefreshSecondsPerBlock float64 = 12
+
+// The RocketStorage namespace RocketNetworkPrices uses to record each oDAO member's price submissions
+const priceSubmittedNodeKey string = "network.prices.submitted.node"
+
 // Submit RPL price task
 type submitRplPrice struct {
 	c   *cli.Context
@@ -70,10 +78,21 @@ type submitRplPrice struct {
 	rp  *rocketpool.RocketPool
 	oio *contracts.OneInchOracle
 	bc  beacon.Client
+
+	// The last time each L2's rate was seen being updated by any member, used for the refresh interval override
+	lastL2Updates map[string]time.Time
+
+	// Whether each L2's rate was stale the last time it was checked, so updates by other members can be detected
+	l2RatesStale map[string]bool
+
+	// Schedules this node's turn to submit prices
+	stagger *submissionStagger
+
+	submissionCollector *collectors.SubmissionCollector
 }
 
 // Create submit RPL price task
-func newSubmitRplPrice(c *cli.Context, logger log.ColorLogger) (*submitRplPrice, error) {
+func newSubmitRplPrice(c *cli.Context, logger log.ColorLogger, submissionCollector *collectors.SubmissionCollector) (*submitRplPrice, error) {
 
 	// Get services
 	cfg, err := services.GetConfig(c)
@@ -111,6 +130,12 @@ func newSubmitRplPrice(c *cli.Context, logger log.ColorLogger) (*submitRplPrice,
 		rp:  rp,
 		oio: oio,
 		bc:  bc,
+
+		lastL2Updates: map[string]time.Time{},
+		l2RatesStale:  map[string]bool{},
+		stagger:       newSubmissionStagger(rp, cfg),
+
+		submissionCollector: submissionCollector,
 	}, nil
 
 }
@@ -163,6 +188,13 @@ func (t *submitRplPrice) run() error {
 		t.log.Printf("Error submitting Optimism price: %q\n", err)
 	}
 
+	// Check if Gnosis rate is stale and submit
+	err = t.submitGnosisPrice()
+	if err != nil {
+		// Error is not fatal for this task so print and continue
+		t.log.Printf("Error submitting Gnosis price: %q\n", err)
+	}
+
 	// Log
 	t.log.Println("Checking for RPL price checkpoint...")
 
@@ -246,6 +278,15 @@ func (t *submitRplPrice) run() error {
 		t.log.Printlnf("Have previously submitted out-of-date prices for block %d, trying again...", blockNumber)
 	}
 
+	// Skip the submission until it's this node's turn; consensus is checked again on the next pass
+	isTurn, err := t.stagger.isSubmissionTurn(fmt.Sprint(blockNumber), nodeAccount.Address, t.printMessage)
+	if err != nil {
+		return err
+	}
+	if !isTurn {
+		return nil
+	}
+
 	// Log
 	t.log.Println("Submitting RPL price...")
 
@@ -280,7 +321,7 @@ func (t *submitRplPrice) hasSubmittedBlockPrices(nodeAddress common.Address, blo
 
 	blockNumberBuf := make([]byte, 32)
 	big.NewInt(int64(blockNumber)).FillBytes(blockNumberBuf)
-	return t.rp.RocketStorage.GetBool(nil, crypto.Keccak256Hash([]byte("network.prices.submitted.node"), nodeAddress.Bytes(), blockNumberBuf))
+	return t.rp.RocketStorage.GetBool(nil, crypto.Keccak256Hash([]byte(priceSubmittedNodeKey), nodeAddress.Bytes(), blockNumberBuf))
 
 }
 
@@ -296,21 +337,63 @@ func (t *submitRplPrice) hasSubmittedSpecificBlockPrices(nodeAddress common.Addr
 	effectiveRplStakeBuf := make([]byte, 32)
 	effectiveRplStake.FillBytes(effectiveRplStakeBuf)
 
-	return t.rp.RocketStorage.GetBool(nil, crypto.Keccak256Hash([]byte("network.prices.submitted.node"), nodeAddress.Bytes(), blockNumberBuf, rplPriceBuf, effectiveRplStakeBuf))
+	return t.rp.RocketStorage.GetBool(nil, crypto.Keccak256Hash([]byte(priceSubmittedNodeKey), nodeAddress.Bytes(), blockNumberBuf, rplPriceBuf, effectiveRplStakeBuf))
+
+}
+
+// Make sure the price submission storage key still matches the deployed contracts by checking that at least one
+// oDAO member is recorded as having submitted the latest prices block; if it doesn't, the submission checks above
+// would silently return false and the node would submit duplicate prices
+func (t *submitRplPrice) verifySubmissionStorageKey() error {
+
+	// Get the latest prices block
+	pricesBlock, err := network.GetPricesBlock(t.rp, nil)
+	if err != nil {
+		return fmt.Errorf("error getting the latest prices block: %w", err)
+	}
+	if pricesBlock == 0 {
+		// Prices haven't been submitted on this network yet, so there's nothing to check against
+		return nil
+	}
+
+	// Look for a member that submitted it
+	count, err := trustednode.GetMemberCount(t.rp, nil)
+	if err != nil {
+		return fmt.Errorf("error getting the oDAO member count: %w", err)
+	}
+	for i := uint64(0); i < count; i++ {
+		memberAddress, err := trustednode.GetMemberAt(t.rp, i, nil)
+		if err != nil {
+			return fmt.Errorf("error getting oDAO member %d: %w", i, err)
+		}
+		submitted, err := t.hasSubmittedBlockPrices(memberAddress, pricesBlock)
+		if err != nil {
+			return fmt.Errorf("error checking if oDAO member %s submitted prices for block %d: %w", memberAddress.Hex(), pricesBlock, err)
+		}
+		if submitted {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("no oDAO member is recorded as having submitted prices for block %d under the storage key \"%s\"; the key no longer matches the deployed contracts, so this node may submit duplicate prices", pricesBlock, priceSubmittedNodeKey)
 
 }
 
 // Get RPL price at block
 func (t *submitRplPrice) getRplPrice(blockNumber uint64) (*big.Int, error) {
 
+	// Use the fixed test price if there's no oracle to get the real price from
+	testPrice := t.cfg.Smartnode.GetRplTestPrice()
+	if testPrice > 0 {
+		t.log.Printlnf("No 1inch oracle is configured, using the test RPL price of %.6f ETH.", testPrice)
+		return eth.EthToWei(testPrice), nil
+	}
+
 	// Require 1inch oracle contract
 	if err := services.RequireOneInchOracle(t.c); err != nil {
 		return nil, err
 	}
 
-	// Get RPL token address
-	rplAddress := common.HexToAddress(t.cfg.Smartnode.GetRplTokenAddress())
-
 	// Initialize call options
 	opts := &bind.CallOpts{
 		BlockNumber: big.NewInt(int64(blockNumber)),
@@ -322,14 +405,8 @@ func (t *submitRplPrice) getRplPrice(blockNumber uint64) (*big.Int, error) {
 		return nil, err
 	}
 
-	// Generate an OIO wrapper using the client
-	oio, err := contracts.NewOneInchOracle(common.HexToAddress(t.cfg.Smartnode.GetOneInchOracleAddress()), client.Client)
-	if err != nil {
-		return nil, err
-	}
-
 	// Get RPL price
-	rplPrice, err := oio.GetRateToEth(opts, rplAddress, true)
+	rplPrice, err := rputils.GetOracleRplPrice(t.cfg, client.Client, opts)
 	if err != nil {
 		return nil, fmt.Errorf("Could not get RPL price at block %d: %w", blockNumber, err)
 	}
@@ -367,24 +444,35 @@ func (t *submitRplPrice) submitRplPrice(blockNumber uint64, rplPrice, effectiveR
 		return nil
 	}
 
+	// Check the node wallet's ETH reserve
+	if ok, err := api.CheckEthReserve(t.cfg, t.rp.Client, opts, gasInfo, maxFee, 0, t.log); err != nil {
+		return err
+	} else if !ok {
+		return nil
+	}
+
 	// Set the gas settings
 	opts.GasFeeCap = maxFee
 	opts.GasTipCap = eth.GweiToWei(WatchtowerMaxPriorityFee)
 	opts.GasLimit = gasInfo.SafeGasLimit
 
 	// Submit RPL price
+	t.submissionCollector.RecordAttempt(collectors.SubmissionTask_RplPrice)
 	hash, err := network.SubmitPrices(t.rp, blockNumber, rplPrice, effectiveRplStake, opts)
 	if err != nil {
+		t.submissionCollector.RecordFailure(collectors.SubmissionTask_RplPrice)
 		return err
 	}
 
 	// Print TX info and wait for it to be included in a block
 	err = api.PrintAndWaitForTransaction(t.cfg, hash, t.rp.Client, t.log)
 	if err != nil {
+		t.submissionCollector.RecordFailure(collectors.SubmissionTask_RplPrice)
 		return err
 	}
 
 	// Log
+	t.submissionCollector.RecordSuccess(collectors.SubmissionTask_RplPrice, blockNumber)
 	t.log.Printlnf("Successfully submitted RPL price for block %d.", blockNumber)
 
 	// Return
@@ -394,8 +482,16 @@ func (t *submitRplPrice) submitRplPrice(blockNumber uint64, rplPrice, effectiveR
 
 // Checks if Optimism rate is stale and if it's our turn to submit, calls submitRate on the messenger
 func (t *submitRplPrice) submitOptimismPrice() error {
-	priceMessengerAddress := t.cfg.Smartnode.GetOptimismMessengerAddress()
+	return t.submitL2Price("Optimism", t.cfg.Smartnode.GetOptimismMessengerAddress())
+}
+
+// Checks if Gnosis rate is stale and if it's our turn to submit, calls submitRate on the messenger
+func (t *submitRplPrice) submitGnosisPrice() error {
+	return t.submitL2Price("Gnosis", t.cfg.Smartnode.GetGnosisMessengerAddress())
+}
 
+// Checks if an L2's rate is stale and if it's our turn to submit, calls submitRate on its messenger
+func (t *submitRplPrice) submitL2Price(chainName string, priceMessengerAddress string) error {
 	if priceMessengerAddress == "" {
 		// No price messenger deployed on the current network
 		return nil
@@ -432,6 +528,26 @@ func (t *submitRplPrice) submitOptimismPrice() error {
 
 	rateStale := *abi.ConvertType(out[0], new(bool)).(*bool)
 
+	// A stale rate that's no longer stale has been submitted by someone, which restarts the refresh interval
+	if t.l2RatesStale[chainName] && !rateStale {
+		t.lastL2Updates[chainName] = time.Now()
+	}
+	t.l2RatesStale[chainName] = rateStale
+
+	// Get current block number
+	blockNumber, err := t.ec.BlockNumber(context.Background())
+	if err != nil {
+		return fmt.Errorf("Failed to get block number: %q", err)
+	}
+
+	// Force a refresh once per refresh interval
+	if !rateStale {
+		rateStale, err = t.isL2RefreshDue(chainName, blockNumber)
+		if err != nil {
+			return err
+		}
+	}
+
 	if !rateStale {
 		// Nothing to do
 		return nil
@@ -457,12 +573,6 @@ func (t *submitRplPrice) submitOptimismPrice() error {
 		}
 	}
 
-	// Get current block number
-	blockNumber, err := t.ec.BlockNumber(context.Background())
-	if err != nil {
-		return fmt.Errorf("Failed to get block number: %q", err)
-	}
-
 	// Calculate whose turn it is to submit
 	indexToSubmit := (blockNumber / BlocksPerTurn) % count
 
@@ -483,7 +593,7 @@ func (t *submitRplPrice) submitOptimismPrice() error {
 			Data:     input,
 		})
 		if err != nil {
-			return fmt.Errorf("Error estimating gas limit of submitOptimismPrice: %w", err)
+			return fmt.Errorf("Error estimating gas limit of submitRate for %s: %w", chainName, err)
 		}
 
 		// Get the safe gas limit
@@ -505,12 +615,19 @@ func (t *submitRplPrice) submitOptimismPrice() error {
 			return nil
 		}
 
+		// Check the node wallet's ETH reserve
+		if ok, err := api.CheckEthReserve(t.cfg, t.rp.Client, opts, gasInfo, maxFee, 0, t.log); err != nil {
+			return err
+		} else if !ok {
+			return nil
+		}
+
 		// Set the gas settings
 		opts.GasFeeCap = maxFee
 		opts.GasTipCap = eth.GweiToWei(WatchtowerMaxPriorityFee)
 		opts.GasLimit = gasInfo.SafeGasLimit
 
-		t.log.Println("Submitting rate to Optimism...")
+		t.log.Printlnf("Submitting rate to %s...", chainName)
 
 		// Submit rates
 		hash, err := priceMessenger.Transact(opts, "submitRate")
@@ -525,9 +642,46 @@ func (t *submitRplPrice) submitOptimismPrice() error {
 		}
 
 		// Log
-		t.log.Printlnf("Successfully submitted Optimism price for block %d.", blockNumber)
+		t.log.Printlnf("Successfully submitted %s price for block %d.", chainName, blockNumber)
+		t.lastL2Updates[chainName] = time.Now()
 
 	}
 
 	return nil
 }
+
+// Checks if a forced refresh of the given chain's rate is due.
+// Refreshes happen during the submission turn that contains the start of each refresh interval, so every member agrees on one refresh
+// per interval and only the member whose turn that is submits it, rather than each member refreshing on its own clock.
+func (t *submitRplPrice) isL2RefreshDue(chainName string, blockNumber uint64) (bool, error) {
+	intervalString := t.cfg.Smartnode.L2RateRefreshInterval.Value.(string)
+	if intervalString == "" {
+		return false, nil
+	}
+	interval, err := time.ParseDuration(intervalString)
+	if err != nil {
+		return false, fmt.Errorf("Invalid L2 rate refresh interval [%s]: %w", intervalString, err)
+	}
+	if interval <= 0 {
+		return false, nil
+	}
+
+	// Skip the refresh if anyone has updated the rate within the interval
+	lastUpdate, exists := t.lastL2Updates[chainName]
+	if exists && time.Since(lastUpdate) < interval {
+		return false, nil
+	}
+
+	// Check if this is the turn that contains the start of the current interval
+	intervalBlocks := uint64(interval.Seconds() / l2RefreshSecondsPerBlock)
+	if intervalBlocks < BlocksPerTurn {
+		intervalBlocks = BlocksPerTurn
+	}
+	intervalStart := blockNumber / intervalBlocks * intervalBlocks
+	if blockNumber/BlocksPerTurn != intervalStart/BlocksPerTurn {
+		return false, nil
+	}
+
+	t.log.Printlnf("A new %s rate refresh interval has started, refreshing the %s rate.", interval, chainName)
+	return true, nil
+}
@@ -0,0 +1,209 @@
+package watchtower
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime/multipart"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	cfgtypes "github.com/rocket-pool/smartnode/shared/types/config"
+	"github.com/web3-storage/go-w3s-client"
+)
+
+// A service that can publish rewards files to IPFS
+type TreeUploader interface {
+	// Upload the file at the given path and get the CID of the directory wrapping it
+	Upload(ctx context.Context, path string) (string, error)
+
+	// The name of the service, for logging
+	Name() string
+}
+
+// Get the tree uploader selected in the Smartnode config
+func getTreeUploader(cfg *config.RocketPoolConfig) (TreeUploader, error) {
+	switch cfg.Smartnode.TreeUploadService.Value.(cfgtypes.TreeUploadService) {
+	case cfgtypes.TreeUploadService_Web3Storage:
+		return newWeb3StorageUploader(cfg)
+	case cfgtypes.TreeUploadService_Ipfs:
+		return newIpfsUploader(cfg)
+	default:
+		return nil, fmt.Errorf("Unknown tree upload service '%v'", cfg.Smartnode.TreeUploadService.Value)
+	}
+}
+
+// Uploads files to Web3.Storage
+type web3StorageUploader struct {
+	client w3s.Client
+}
+
+// Create a new Web3.Storage uploader
+func newWeb3StorageUploader(cfg *config.RocketPoolConfig) (*web3StorageUploader, error) {
+
+	// Get the API token
+	apiToken := cfg.Smartnode.Web3StorageApiToken.Value.(string)
+	if apiToken == "" {
+		return nil, fmt.Errorf("***ERROR***\nYou have not configured your Web3.Storage API token yet, so you cannot submit Merkle rewards trees.\nPlease get an API token from https://web3.storage and enter it in the Smartnode section of the `service config` TUI (or use `--smartnode-web3StorageApiToken` if you configure your system headlessly).")
+	}
+
+	// Create the client
+	client, err := w3s.NewClient(w3s.WithToken(apiToken))
+	if err != nil {
+		return nil, fmt.Errorf("Error creating new Web3.Storage client: %w", err)
+	}
+
+	return &web3StorageUploader{
+		client: client,
+	}, nil
+
+}
+
+func (u *web3StorageUploader) Name() string {
+	return "Web3.Storage"
+}
+
+func (u *web3StorageUploader) Upload(ctx context.Context, path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", fmt.Errorf("Error opening %s: %w", path, err)
+	}
+	defer file.Close()
+
+	cid, err := u.client.Put(ctx, file)
+	if err != nil {
+		return "", err
+	}
+	return cid.String(), nil
+}
+
+// Settings for the IPFS HTTP API uploader; these pin the DAG layout to the one Web3.Storage builds so both services produce the same CID for a file
+const (
+	ipfsChunkSize int    = 1024 * 1024
+	ipfsChunker   string = "size-1048576"
+
+	// Kubo links at most 174 chunks per node while Web3.Storage links up to 1024, so the layouts only match while a file fits in one node
+	ipfsMaxChunks int = 174
+
+	// A file that spans two chunks, and the CID Web3.Storage gives it, used to check that the IPFS node builds the same DAG
+	ipfsCheckFilename    string = "rp-ipfs-check.txt"
+	ipfsCheckLine        string = "Rocket Pool rewards tree upload check\n"
+	ipfsCheckLineRepeats int    = 50000
+	ipfsCheckCid         string = "bafybeia46txzlf4juzsm3t6gecwmoyhtd2hx67ogdfltu7iofu6yvtj2xe"
+)
+
+// Uploads files to an IPFS node or pinning service that supports the IPFS HTTP API
+type ipfsUploader struct {
+	apiUrl   string
+	verified bool
+}
+
+// A single entry in the response of the IPFS HTTP API's add route
+type ipfsAddResponse struct {
+	Name string `json:"Name"`
+	Hash string `json:"Hash"`
+}
+
+// Create a new IPFS HTTP API uploader
+func newIpfsUploader(cfg *config.RocketPoolConfig) (*ipfsUploader, error) {
+	apiUrl := cfg.Smartnode.IpfsApiUrl.Value.(string)
+	if apiUrl == "" {
+		return nil, fmt.Errorf("***ERROR***\nYou have selected an IPFS node for uploading Merkle rewards trees but have not configured its API URL yet.\nPlease enter it in the Smartnode section of the `service config` TUI (or use `--smartnode-ipfsApiUrl` if you configure your system headlessly).")
+	}
+	return &ipfsUploader{
+		apiUrl: strings.TrimSuffix(apiUrl, "/"),
+	}, nil
+}
+
+func (u *ipfsUploader) Name() string {
+	return "IPFS"
+}
+
+func (u *ipfsUploader) Upload(ctx context.Context, path string) (string, error) {
+
+	// Make sure the node builds the same CIDs as Web3.Storage before submitting anything
+	if !u.verified {
+		checkData := bytes.Repeat([]byte(ipfsCheckLine), ipfsCheckLineRepeats)
+		cid, err := u.add(ctx, ipfsCheckFilename, checkData, true)
+		if err != nil {
+			return "", fmt.Errorf("Error checking the IPFS node's CID generation: %w", err)
+		}
+		if cid != ipfsCheckCid {
+			return "", fmt.Errorf("The IPFS node generated CID %s for the check file instead of %s, so its rewards tree CIDs would not match the other Oracle DAO members'", cid, ipfsCheckCid)
+		}
+		u.verified = true
+	}
+
+	// Read the file
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("Error reading %s: %w", path, err)
+	}
+	if len(data) > ipfsChunkSize*ipfsMaxChunks {
+		return "", fmt.Errorf("%s is %d bytes, but the IPFS node can only match Web3.Storage's CIDs for files up to %d bytes", path, len(data), ipfsChunkSize*ipfsMaxChunks)
+	}
+
+	return u.add(ctx, filepath.Base(path), data, false)
+
+}
+
+// Add a file to the IPFS node, wrapped in a directory, and get the directory's CID
+func (u *ipfsUploader) add(ctx context.Context, name string, data []byte, onlyHash bool) (string, error) {
+
+	// Build the multipart form
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	part, err := writer.CreateFormFile("file", name)
+	if err != nil {
+		return "", fmt.Errorf("Error creating IPFS upload form: %w", err)
+	}
+	if _, err := part.Write(data); err != nil {
+		return "", fmt.Errorf("Error creating IPFS upload form: %w", err)
+	}
+	if err := writer.Close(); err != nil {
+		return "", fmt.Errorf("Error creating IPFS upload form: %w", err)
+	}
+
+	// Use the same chunker, leaf format, CID version, and hash as Web3.Storage, and wrap the file in a directory like it does
+	url := fmt.Sprintf("%s/api/v0/add?pin=%t&only-hash=%t&cid-version=1&hash=sha2-256&raw-leaves=true&chunker=%s&wrap-with-directory=true", u.apiUrl, !onlyHash, onlyHash, ipfsChunker)
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, url, body)
+	if err != nil {
+		return "", fmt.Errorf("Error creating IPFS upload request: %w", err)
+	}
+	request.Header.Set("Content-Type", writer.FormDataContentType())
+
+	// Upload it
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		return "", err
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		message, _ := ioutil.ReadAll(response.Body)
+		return "", fmt.Errorf("IPFS node returned status %s: %s", response.Status, strings.TrimSpace(string(message)))
+	}
+
+	// The response has one entry per added object; the wrapping directory has an empty name
+	decoder := json.NewDecoder(response.Body)
+	for {
+		var entry ipfsAddResponse
+		err := decoder.Decode(&entry)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return "", fmt.Errorf("Error decoding IPFS upload response: %w", err)
+		}
+		if entry.Name == "" {
+			return entry.Hash, nil
+		}
+	}
+	return "", fmt.Errorf("IPFS upload response did not include the wrapping directory")
+
+}
@@ -36,6 +36,7 @@ const (
 	SubmitRewardsTreeColor           = color.FgHiCyan
 	WarningColor                     = color.FgYellow
 	ProcessPenaltiesColor            = color.FgHiMagenta
+	ExecuteProposalsColor            = color.FgHiBlue
 )
 
 // Register watchtower command
@@ -61,53 +62,78 @@ func run(c *cli.Context) error {
 		return err
 	}
 
-	// Initialize the scrub metrics reporter
+	// Initialize the metrics reporters
 	scrubCollector := collectors.NewScrubCollector()
+	submissionCollector := collectors.NewSubmissionCollector()
+
+	// Get the config
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return err
+	}
+	jsonLogs := (cfg.Smartnode.WatchtowerJsonLogs.Value == true)
+
+	// Refuse to submit anything if the Beacon Node is on a different network than the Execution client
+	if err := services.WaitBeaconClientSynced(c, true); err != nil {
+		return err
+	}
+	if err := verifyChainMatch(c); err != nil {
+		return fmt.Errorf("error verifying the Beacon Node's network: %w", err)
+	}
 
 	// Initialize error logger
-	errorLog := log.NewColorLogger(ErrorColor)
+	errorLog := newTaskLogger(jsonLogs, ErrorColor, "Error", "error")
 
 	// Initialize tasks
-	respondChallenges, err := newRespondChallenges(c, log.NewColorLogger(RespondChallengesColor))
+	respondChallenges, err := newRespondChallenges(c, newTaskLogger(jsonLogs, RespondChallengesColor, "Respond Challenges", "info"))
 	if err != nil {
 		return fmt.Errorf("error during respond-to-challenges check: %w", err)
 	}
-	submitRplPrice, err := newSubmitRplPrice(c, log.NewColorLogger(SubmitRplPriceColor))
+	submitRplPrice, err := newSubmitRplPrice(c, newTaskLogger(jsonLogs, SubmitRplPriceColor, "Price Report", "info"), submissionCollector)
 	if err != nil {
 		return fmt.Errorf("error during rpl price check: %w", err)
 	}
-	submitNetworkBalances, err := newSubmitNetworkBalances(c, log.NewColorLogger(SubmitNetworkBalancesColor))
+	submitNetworkBalances, err := newSubmitNetworkBalances(c, newTaskLogger(jsonLogs, SubmitNetworkBalancesColor, "Balances Report", "info"))
 	if err != nil {
 		return fmt.Errorf("error during network balances check: %w", err)
 	}
-	submitWithdrawableMinipools, err := newSubmitWithdrawableMinipools(c, log.NewColorLogger(SubmitWithdrawableMinipoolsColor))
+	submitWithdrawableMinipools, err := newSubmitWithdrawableMinipools(c, newTaskLogger(jsonLogs, SubmitWithdrawableMinipoolsColor, "Withdrawable Minipools", "info"))
 	if err != nil {
 		return fmt.Errorf("error during withdrawable minipools check: %w", err)
 	}
-	dissolveTimedOutMinipools, err := newDissolveTimedOutMinipools(c, log.NewColorLogger(DissolveTimedOutMinipoolsColor))
+	dissolveTimedOutMinipools, err := newDissolveTimedOutMinipools(c, newTaskLogger(jsonLogs, DissolveTimedOutMinipoolsColor, "Dissolve Minipools", "info"))
 	if err != nil {
 		return fmt.Errorf("error during timed-out minipools check: %w", err)
 	}
-	processWithdrawals, err := newProcessWithdrawals(c, log.NewColorLogger(ProcessWithdrawalsColor))
+	processWithdrawals, err := newProcessWithdrawals(c, newTaskLogger(jsonLogs, ProcessWithdrawalsColor, "Process Withdrawals", "info"))
 	if err != nil {
 		return fmt.Errorf("error during withdrawal processing check: %w", err)
 	}
-	submitScrubMinipools, err := newSubmitScrubMinipools(c, log.NewColorLogger(SubmitScrubMinipoolsColor), errorLog, scrubCollector)
+	submitScrubMinipools, err := newSubmitScrubMinipools(c, newTaskLogger(jsonLogs, SubmitScrubMinipoolsColor, "Minipool Scrub", "info"), errorLog, scrubCollector)
 	if err != nil {
 		return fmt.Errorf("error during scrub check: %w", err)
 	}
-	submitRewardsTree, err := newSubmitRewardsTree(c, log.NewColorLogger(SubmitRewardsTreeColor), errorLog)
+	submitRewardsTree, err := newSubmitRewardsTree(c, newTaskLogger(jsonLogs, SubmitRewardsTreeColor, "Merkle Tree", "info"), errorLog, submissionCollector)
 	if err != nil {
 		return fmt.Errorf("error during rewards tree check: %w", err)
 	}
-	/*processPenalties, err := newProcessPenalties(c, log.NewColorLogger(ProcessPenaltiesColor), errorLog)
+	/*processPenalties, err := newProcessPenalties(c, newTaskLogger(jsonLogs, ProcessPenaltiesColor, "Fee Recipients", "info"), errorLog)
 	if err != nil {
 		return fmt.Errorf("error during penalties check: %w", err)
 	}*/
-	generateRewardsTree, err := newGenerateRewardsTree(c, log.NewColorLogger(SubmitRewardsTreeColor), errorLog)
+	generateRewardsTree, err := newGenerateRewardsTree(c, newTaskLogger(jsonLogs, SubmitRewardsTreeColor, "Merkle Tree", "info"), errorLog)
 	if err != nil {
 		return fmt.Errorf("error during manual tree generation check: %w", err)
 	}
+	executeProposals, err := newExecuteProposals(c, newTaskLogger(jsonLogs, ExecuteProposalsColor, "Execute Proposals", "info"))
+	if err != nil {
+		return fmt.Errorf("error during proposal execution check: %w", err)
+	}
+
+	// Check that the price submission storage key matches the deployed contracts
+	if err := submitRplPrice.verifySubmissionStorageKey(); err != nil {
+		errorLog.Printlnf("WARNING: could not verify the RPL price submission storage key: %s", err.Error())
+	}
 
 	intervalDelta := maxTasksInterval - minTasksInterval
 	secondsDelta := intervalDelta.Seconds()
@@ -185,6 +211,12 @@ func run(c *cli.Context) error {
 					if err := submitScrubMinipools.run(); err != nil {
 						errorLog.Println(err)
 					}
+					time.Sleep(taskCooldown)
+
+					// Run the proposal execution check
+					if err := executeProposals.run(); err != nil {
+						errorLog.Println(err)
+					}
 					/*time.Sleep(taskCooldown)
 
 					// Run the fee recipient penalty check
@@ -201,7 +233,7 @@ func run(c *cli.Context) error {
 
 	// Run metrics loop
 	go func() {
-		err := runMetricsServer(c, log.NewColorLogger(MetricsColor), scrubCollector)
+		err := runMetricsServer(c, newTaskLogger(jsonLogs, MetricsColor, "Metrics", "info"), scrubCollector, submissionCollector)
 		if err != nil {
 			errorLog.Println(err)
 		}
@@ -222,3 +254,11 @@ func configureHTTP() {
 	http.DefaultTransport.(*http.Transport).MaxIdleConnsPerHost = MaxConcurrentEth1Requests
 
 }
+
+// Create a logger for a task, writing JSON lines with the given task name and level if JSON logging is enabled
+func newTaskLogger(jsonLogs bool, colorAttr color.Attribute, task string, level string) log.ColorLogger {
+	if jsonLogs {
+		return log.NewJsonLogger(task, level)
+	}
+	return log.NewColorLogger(colorAttr)
+}
@@ -3,6 +3,8 @@ package services
 import (
 	"fmt"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/fatih/color"
 	"github.com/rocket-pool/rocketpool-go/types"
@@ -16,12 +18,19 @@ import (
 
 // This is a proxy for multiple Beacon clients, providing natural fallback support if one of them fails.
 type BeaconClientManager struct {
-	primaryBc       beacon.Client
-	fallbackBc      beacon.Client
-	logger          log.ColorLogger
-	primaryReady    bool
-	fallbackReady   bool
-	ignoreSyncCheck bool
+	primaryBc        beacon.Client
+	fallbackBc       beacon.Client
+	logger           log.ColorLogger
+	primaryReady     bool
+	fallbackReady    bool
+	ignoreSyncCheck  bool
+	promotionDelay   time.Duration
+	recheckDelay     time.Duration
+	primaryDownSince time.Time
+	promoted         bool
+	lastDemotedCheck time.Time
+	demotedStatus    api.ClientStatus
+	lock             sync.RWMutex
 }
 
 // This is a signature for a wrapped Beacon client function that only returns an error
@@ -71,27 +80,53 @@ func NewBeaconClientManager(cfg *config.RocketPoolConfig) (*BeaconClientManager,
 		}
 	}
 
+	// Get the validator status request limits
+	batchSize := int(cfg.Smartnode.ValidatorStatusBatchSize.Value.(uint64))
+	timeoutString := cfg.Smartnode.ValidatorStatusTimeout.Value.(string)
+	timeout, err := time.ParseDuration(timeoutString)
+	if err != nil {
+		return nil, fmt.Errorf("invalid validator status timeout [%s]: %w", timeoutString, err)
+	}
+
+	// Get the custom headers for hosted Beacon Nodes; each client gets its own so credentials aren't shared between providers
+	headers, err := getBeaconApiHeaders(&cfg.Smartnode.BeaconApiHeaders, &cfg.Smartnode.BeaconApiToken)
+	if err != nil {
+		return nil, err
+	}
+	fallbackHeaders, err := getBeaconApiHeaders(&cfg.Smartnode.FallbackBeaconApiHeaders, &cfg.Smartnode.FallbackBeaconApiToken)
+	if err != nil {
+		return nil, err
+	}
+
 	var primaryBc beacon.Client
 	var fallbackBc beacon.Client
 	switch selectedCC {
 	case cfgtypes.ConsensusClient_Nimbus:
-		primaryBc = client.NewNimbusClient(primaryProvider)
+		primaryBc = client.NewNimbusClient(primaryProvider, batchSize, timeout, headers)
 		if fallbackProvider != "" {
-			fallbackBc = client.NewNimbusClient(fallbackProvider)
+			fallbackBc = client.NewNimbusClient(fallbackProvider, batchSize, timeout, fallbackHeaders)
 		}
 	default:
-		primaryBc = client.NewStandardHttpClient(primaryProvider)
+		primaryBc = client.NewStandardHttpClient(primaryProvider, batchSize, timeout, headers)
 		if fallbackProvider != "" {
-			fallbackBc = client.NewStandardHttpClient(fallbackProvider)
+			fallbackBc = client.NewStandardHttpClient(fallbackProvider, batchSize, timeout, fallbackHeaders)
 		}
 	}
 
+	// Get the fallback promotion settings
+	promotionDelay, recheckDelay, err := getFallbackPromotionSettings(cfg)
+	if err != nil {
+		return nil, err
+	}
+
 	return &BeaconClientManager{
-		primaryBc:     primaryBc,
-		fallbackBc:    fallbackBc,
-		logger:        log.NewColorLogger(color.FgHiBlue),
-		primaryReady:  true,
-		fallbackReady: fallbackBc != nil,
+		primaryBc:      primaryBc,
+		fallbackBc:     fallbackBc,
+		logger:         log.NewColorLogger(color.FgHiBlue),
+		primaryReady:   true,
+		fallbackReady:  fallbackBc != nil,
+		promotionDelay: promotionDelay,
+		recheckDelay:   recheckDelay,
 	}, nil
 
 }
@@ -201,13 +236,14 @@ func (m *BeaconClientManager) GetValidatorStatus(pubkey types.ValidatorPubkey, o
 
 // Get the statuses of multiple validators by their pubkeys
 func (m *BeaconClientManager) GetValidatorStatuses(pubkeys []types.ValidatorPubkey, opts *beacon.ValidatorStatusOptions) (map[types.ValidatorPubkey]beacon.ValidatorStatus, error) {
-	result, err := m.runFunction1(func(client beacon.Client) (interface{}, error) {
-		return client.GetValidatorStatuses(pubkeys, opts)
+	// Keep the statuses from the last attempt, since a timed-out request still returns partial results alongside its error
+	var statuses map[types.ValidatorPubkey]beacon.ValidatorStatus
+	_, err := m.runFunction1(func(client beacon.Client) (interface{}, error) {
+		var err error
+		statuses, err = client.GetValidatorStatuses(pubkeys, opts)
+		return statuses, err
 	})
-	if err != nil {
-		return nil, err
-	}
-	return result.(map[types.ValidatorPubkey]beacon.ValidatorStatus), nil
+	return statuses, err
 }
 
 // Get a validator's index
@@ -254,6 +290,17 @@ func (m *BeaconClientManager) GetDomainData(domainType []byte, epoch uint64) ([]
 	return result.([]byte), nil
 }
 
+// Get the fork version the Beacon chain uses for signatures at the given epoch
+func (m *BeaconClientManager) GetForkVersion(epoch uint64) ([]byte, error) {
+	result, err := m.runFunction1(func(client beacon.Client) (interface{}, error) {
+		return client.GetForkVersion(epoch)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.([]byte), nil
+}
+
 // Voluntarily exit a validator
 func (m *BeaconClientManager) ExitValidator(validatorIndex, epoch uint64, signature types.ValidatorSignature) error {
 	err := m.runFunction0(func(client beacon.Client) error {
@@ -298,6 +345,9 @@ func (m *BeaconClientManager) GetCommitteesForEpoch(epoch *uint64) ([]beacon.Com
 
 func (m *BeaconClientManager) CheckStatus() *api.ClientManagerStatus {
 
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
 	status := &api.ClientManagerStatus{
 		FallbackEnabled: m.fallbackBc != nil,
 	}
@@ -318,13 +368,16 @@ func (m *BeaconClientManager) CheckStatus() *api.ClientManagerStatus {
 
 	// Get the fallback BC status if applicable
 	if status.FallbackEnabled {
-		status.FallbackClientStatus = checkBcStatus(m.fallbackBc)
+		status.FallbackClientStatus = m.checkFallbackStatus()
 	}
 
 	// Flag the ready clients
 	m.primaryReady = (status.PrimaryClientStatus.IsWorking && status.PrimaryClientStatus.IsSynced)
 	m.fallbackReady = (status.FallbackEnabled && status.FallbackClientStatus.IsWorking && status.FallbackClientStatus.IsSynced)
 
+	// Promote the fallback or restore the original primary if necessary
+	m.updatePromotion(status)
+
 	return status
 
 }
@@ -357,18 +410,81 @@ func checkBcStatus(client beacon.Client) api.ClientStatus {
 
 }
 
+// Check the fallback client status; if it's a demoted primary, only re-check it once per recheck delay.
+// The caller must hold the write lock.
+func (m *BeaconClientManager) checkFallbackStatus() api.ClientStatus {
+	if m.promoted && time.Since(m.lastDemotedCheck) < m.recheckDelay {
+		return m.demotedStatus
+	}
+	status := checkBcStatus(m.fallbackBc)
+	if m.promoted {
+		m.lastDemotedCheck = time.Now()
+		m.demotedStatus = status
+	}
+	return status
+}
+
+// Promote the fallback client if the primary has been down for longer than the promotion delay,
+// or restore the original primary once it has recovered; the caller must hold the write lock
+func (m *BeaconClientManager) updatePromotion(status *api.ClientManagerStatus) {
+
+	if m.promotionDelay == 0 || !status.FallbackEnabled {
+		return
+	}
+
+	// Restore the original primary once it's ready again
+	if m.promoted {
+		if m.fallbackReady {
+			m.logger.Println("NOTICE: The original primary Beacon client has recovered, restoring it as the primary client.")
+			m.swapClients()
+		}
+		return
+	}
+
+	// Track how long the primary has been down
+	if m.primaryReady {
+		m.primaryDownSince = time.Time{}
+		return
+	}
+	if m.primaryDownSince.IsZero() {
+		m.primaryDownSince = time.Now()
+		return
+	}
+
+	// Promote the fallback if it's ready and the primary has been down for too long
+	downtime := time.Since(m.primaryDownSince)
+	if downtime >= m.promotionDelay && m.fallbackReady {
+		m.logger.Printlnf("NOTICE: The primary Beacon client has been unavailable for %s, promoting the fallback client to primary.", downtime)
+		m.demotedStatus = status.PrimaryClientStatus
+		m.lastDemotedCheck = time.Now()
+		m.swapClients()
+	}
+
+}
+
+// Swap the primary and fallback clients; the caller must hold the write lock
+func (m *BeaconClientManager) swapClients() {
+	m.primaryBc, m.fallbackBc = m.fallbackBc, m.primaryBc
+	m.primaryReady, m.fallbackReady = m.fallbackReady, m.primaryReady
+	m.primaryDownSince = time.Time{}
+	m.promoted = !m.promoted
+}
+
 // Attempts to run a function progressively through each client until one succeeds or they all fail.
 func (m *BeaconClientManager) runFunction0(function bcFunction0) error {
 
+	// Get a consistent snapshot of the clients in case they're swapped while the function runs
+	primaryBc, primaryReady, fallbackBc, fallbackReady := m.getClients()
+
 	// Check if we can use the primary
-	if m.primaryReady {
+	if primaryReady {
 		// Try to run the function on the primary
-		err := function(m.primaryBc)
+		err := function(primaryBc)
 		if err != nil {
 			if m.isDisconnected(err) {
 				// If it's disconnected, log it and try the fallback
 				m.logger.Printlnf("WARNING: Primary Beacon client disconnected (%s), using fallback...", err.Error())
-				m.primaryReady = false
+				m.setNotReady(primaryBc)
 				return m.runFunction0(function)
 			}
 			// If it's a different error, just return it
@@ -378,14 +494,14 @@ func (m *BeaconClientManager) runFunction0(function bcFunction0) error {
 		return nil
 	}
 
-	if m.fallbackReady {
+	if fallbackReady {
 		// Try to run the function on the fallback
-		err := function(m.fallbackBc)
+		err := function(fallbackBc)
 		if err != nil {
 			if m.isDisconnected(err) {
 				// If it's disconnected, log it and try the fallback
 				m.logger.Printlnf("WARNING: Fallback Beacon client disconnected (%s)", err.Error())
-				m.fallbackReady = false
+				m.setNotReady(fallbackBc)
 				return fmt.Errorf("all Beacon clients failed")
 			}
 
@@ -402,15 +518,18 @@ func (m *BeaconClientManager) runFunction0(function bcFunction0) error {
 // Attempts to run a function progressively through each client until one succeeds or they all fail.
 func (m *BeaconClientManager) runFunction1(function bcFunction1) (interface{}, error) {
 
+	// Get a consistent snapshot of the clients in case they're swapped while the function runs
+	primaryBc, primaryReady, fallbackBc, fallbackReady := m.getClients()
+
 	// Check if we can use the primary
-	if m.primaryReady {
+	if primaryReady {
 		// Try to run the function on the primary
-		result, err := function(m.primaryBc)
+		result, err := function(primaryBc)
 		if err != nil {
 			if m.isDisconnected(err) {
 				// If it's disconnected, log it and try the fallback
 				m.logger.Printlnf("WARNING: Primary Beacon client disconnected (%s), using fallback...", err.Error())
-				m.primaryReady = false
+				m.setNotReady(primaryBc)
 				return m.runFunction1(function)
 			}
 			// If it's a different error, just return it
@@ -420,14 +539,14 @@ func (m *BeaconClientManager) runFunction1(function bcFunction1) (interface{}, e
 		return result, nil
 	}
 
-	if m.fallbackReady {
+	if fallbackReady {
 		// Try to run the function on the fallback
-		result, err := function(m.fallbackBc)
+		result, err := function(fallbackBc)
 		if err != nil {
 			if m.isDisconnected(err) {
 				// If it's disconnected, log it and try the fallback
 				m.logger.Printlnf("WARNING: Fallback Beacon client disconnected (%s)", err.Error())
-				m.fallbackReady = false
+				m.setNotReady(fallbackBc)
 				return nil, fmt.Errorf("all Beacon clients failed")
 			}
 			// If it's a different error, just return it
@@ -444,15 +563,18 @@ func (m *BeaconClientManager) runFunction1(function bcFunction1) (interface{}, e
 // Attempts to run a function progressively through each client until one succeeds or they all fail.
 func (m *BeaconClientManager) runFunction2(function bcFunction2) (interface{}, interface{}, error) {
 
+	// Get a consistent snapshot of the clients in case they're swapped while the function runs
+	primaryBc, primaryReady, fallbackBc, fallbackReady := m.getClients()
+
 	// Check if we can use the primary
-	if m.primaryReady {
+	if primaryReady {
 		// Try to run the function on the primary
-		result1, result2, err := function(m.primaryBc)
+		result1, result2, err := function(primaryBc)
 		if err != nil {
 			if m.isDisconnected(err) {
 				// If it's disconnected, log it and try the fallback
 				m.logger.Printlnf("WARNING: Primary Beacon client disconnected (%s), using fallback...", err.Error())
-				m.primaryReady = false
+				m.setNotReady(primaryBc)
 				return m.runFunction2(function)
 			}
 			// If it's a different error, just return it
@@ -462,14 +584,14 @@ func (m *BeaconClientManager) runFunction2(function bcFunction2) (interface{}, i
 		return result1, result2, nil
 	}
 
-	if m.fallbackReady {
+	if fallbackReady {
 		// Try to run the function on the fallback
-		result1, result2, err := function(m.fallbackBc)
+		result1, result2, err := function(fallbackBc)
 		if err != nil {
 			if m.isDisconnected(err) {
 				// If it's disconnected, log it and try the fallback
 				m.logger.Printlnf("WARNING: Fallback Beacon client disconnected (%s)", err.Error())
-				m.fallbackReady = false
+				m.setNotReady(fallbackBc)
 				return nil, nil, fmt.Errorf("all Beacon clients failed")
 			}
 			// If it's a different error, just return it
@@ -483,7 +605,54 @@ func (m *BeaconClientManager) runFunction2(function bcFunction2) (interface{}, i
 
 }
 
+// Get the current clients and their ready flags
+func (m *BeaconClientManager) getClients() (beacon.Client, bool, beacon.Client, bool) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	return m.primaryBc, m.primaryReady, m.fallbackBc, m.fallbackReady
+}
+
+// Get the ready flags of the primary and fallback clients
+func (m *BeaconClientManager) getReadyFlags() (bool, bool) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	return m.primaryReady, m.fallbackReady
+}
+
+// Flag a client as not ready, unless it was swapped out since it was retrieved
+func (m *BeaconClientManager) setNotReady(client beacon.Client) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if client == m.primaryBc {
+		m.primaryReady = false
+	} else if client == m.fallbackBc {
+		m.fallbackReady = false
+	}
+}
+
 // Returns true if the error was a connection failure and a backup client is available
 func (m *BeaconClientManager) isDisconnected(err error) bool {
 	return strings.Contains(err.Error(), "dial tcp")
 }
+
+// Get the custom headers to send with every Beacon Node request, including the bearer token if one is set
+func getBeaconApiHeaders(headersParam *cfgtypes.Parameter, tokenParam *cfgtypes.Parameter) (map[string]string, error) {
+	headers := map[string]string{}
+	headersString := strings.TrimSpace(headersParam.Value.(string))
+	if headersString != "" {
+		for _, header := range strings.Split(headersString, ",") {
+			elements := strings.SplitN(header, ":", 2)
+			name := strings.TrimSpace(elements[0])
+			if len(elements) != 2 || name == "" {
+				return nil, fmt.Errorf("invalid header [%s] in %s; headers must be in the form 'Name: Value'", strings.TrimSpace(header), headersParam.Name)
+			}
+			headers[name] = strings.TrimSpace(elements[1])
+		}
+	}
+
+	token := strings.TrimSpace(tokenParam.Value.(string))
+	if token != "" {
+		headers["Authorization"] = "Bearer " + token
+	}
+	return headers, nil
+}
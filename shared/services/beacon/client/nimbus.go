@@ -1,15 +1,19 @@
 package client
 
-import "github.com/rocket-pool/smartnode/shared/services/beacon"
+import (
+	"time"
+
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+)
 
 type NimbusClient struct {
 	StandardHttpClient
 }
 
 // Create a new client instance
-func NewNimbusClient(providerAddress string) *NimbusClient {
+func NewNimbusClient(providerAddress string, validatorStatusBatchSize int, validatorStatusTimeout time.Duration, headers map[string]string) *NimbusClient {
 	return &NimbusClient{
-		StandardHttpClient: *NewStandardHttpClient(providerAddress),
+		StandardHttpClient: *NewStandardHttpClient(providerAddress, validatorStatusBatchSize, validatorStatusTimeout, headers),
 	}
 }
 
@@ -2,6 +2,7 @@ package client
 
 import (
 	"bytes"
+	"context"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
@@ -46,13 +47,25 @@ const (
 
 // Beacon client using the standard Beacon HTTP REST API (https://ethereum.github.io/beacon-APIs/)
 type StandardHttpClient struct {
-	providerAddress string
+	providerAddress          string
+	validatorStatusBatchSize int
+	validatorStatusTimeout   time.Duration
+	headers                  map[string]string
 }
 
 // Create a new client instance
-func NewStandardHttpClient(providerAddress string) *StandardHttpClient {
+// validatorStatusBatchSize is the number of validators to request at a time when getting validator statuses (0 uses MaxRequestValidatorsCount),
+// and validatorStatusTimeout is how long to wait for all of the batches before giving up (0 waits forever).
+// headers are added to every request, e.g. for authenticating with a hosted Beacon Node.
+func NewStandardHttpClient(providerAddress string, validatorStatusBatchSize int, validatorStatusTimeout time.Duration, headers map[string]string) *StandardHttpClient {
+	if validatorStatusBatchSize <= 0 {
+		validatorStatusBatchSize = MaxRequestValidatorsCount
+	}
 	return &StandardHttpClient{
-		providerAddress: providerAddress,
+		providerAddress:          providerAddress,
+		validatorStatusBatchSize: validatorStatusBatchSize,
+		validatorStatusTimeout:   validatorStatusTimeout,
+		headers:                  headers,
 	}
 }
 
@@ -254,9 +267,9 @@ func (c *StandardHttpClient) GetValidatorStatuses(pubkeys []types.ValidatorPubke
 		pubkeysHex[vi] = hexutil.AddPrefix(pubkeys[vi].Hex())
 	}
 
-	// Get validators
+	// Get validators; if this times out, the statuses that were retrieved are still returned along with the error
 	validators, err := c.getValidatorsByOpts(pubkeysHex, opts)
-	if err != nil {
+	if err != nil && len(validators.Data) == 0 {
 		return nil, err
 	}
 
@@ -290,7 +303,7 @@ func (c *StandardHttpClient) GetValidatorStatuses(pubkeys []types.ValidatorPubke
 	}
 
 	// Return
-	return statuses, nil
+	return statuses, err
 
 }
 
@@ -416,12 +429,7 @@ func (c *StandardHttpClient) GetDomainData(domainType []byte, epoch uint64) ([]b
 	}
 
 	// Get fork version
-	var forkVersion []byte
-	if epoch < uint64(fork.Data.Epoch) {
-		forkVersion = fork.Data.PreviousVersion
-	} else {
-		forkVersion = fork.Data.CurrentVersion
-	}
+	forkVersion := getForkVersionAtEpoch(fork, epoch)
 
 	// Compute & return domain
 	var dt [4]byte
@@ -430,6 +438,23 @@ func (c *StandardHttpClient) GetDomainData(domainType []byte, epoch uint64) ([]b
 
 }
 
+// Get the fork version the Beacon chain uses for signatures at the given epoch
+func (c *StandardHttpClient) GetForkVersion(epoch uint64) ([]byte, error) {
+	fork, err := c.getFork("head")
+	if err != nil {
+		return []byte{}, err
+	}
+	return getForkVersionAtEpoch(fork, epoch), nil
+}
+
+// Get the fork version that applies at the given epoch, based on the head fork
+func getForkVersionAtEpoch(fork ForkResponse, epoch uint64) []byte {
+	if epoch < uint64(fork.Data.Epoch) {
+		return fork.Data.PreviousVersion
+	}
+	return fork.Data.CurrentVersion
+}
+
 // Perform a voluntary exit on a validator
 func (c *StandardHttpClient) ExitValidator(validatorIndex, epoch uint64, signature types.ValidatorSignature) error {
 	return c.postVoluntaryExit(VoluntaryExitRequest{
@@ -507,6 +532,16 @@ func (c *StandardHttpClient) GetBeaconBlock(blockId string) (beacon.BeaconBlock,
 		beaconBlock.HasExecutionPayload = true
 		beaconBlock.FeeRecipient = common.BytesToAddress(block.Data.Message.Body.ExecutionPayload.FeeRecipient)
 		beaconBlock.ExecutionBlockNumber = uint64(block.Data.Message.Body.ExecutionPayload.BlockNumber)
+
+		// Withdrawals only exist after Capella
+		for _, withdrawal := range block.Data.Message.Body.ExecutionPayload.Withdrawals {
+			beaconBlock.Withdrawals = append(beaconBlock.Withdrawals, beacon.WithdrawalInfo{
+				Index:          uint64(withdrawal.Index),
+				ValidatorIndex: uint64(withdrawal.ValidatorIndex),
+				Address:        common.BytesToAddress(withdrawal.Address),
+				Amount:         uint64(withdrawal.Amount),
+			})
+		}
 	}
 
 	// Add attestation info
@@ -645,13 +680,13 @@ func (c *StandardHttpClient) getFork(stateId string) (ForkResponse, error) {
 	return fork, nil
 }
 
-// Get validators
-func (c *StandardHttpClient) getValidators(stateId string, pubkeys []string) (ValidatorsResponse, error) {
+// Get validators, aborting the request if the context is cancelled
+func (c *StandardHttpClient) getValidators(ctx context.Context, stateId string, pubkeys []string) (ValidatorsResponse, error) {
 	var query string
 	if len(pubkeys) > 0 {
 		query = fmt.Sprintf("?id=%s", strings.Join(pubkeys, ","))
 	}
-	responseBody, status, err := c.getRequest(fmt.Sprintf(RequestValidatorsPath, stateId) + query)
+	responseBody, status, err := c.getRequestWithContext(ctx, fmt.Sprintf(RequestValidatorsPath, stateId)+query)
 	if err != nil {
 		return ValidatorsResponse{}, fmt.Errorf("Could not get validators: %w", err)
 	}
@@ -690,13 +725,21 @@ func (c *StandardHttpClient) getValidatorsByOpts(pubkeysOrIndices []string, opts
 		return ValidatorsResponse{}, fmt.Errorf("must specify a slot or epoch when calling getValidatorsByOpts")
 	}
 
+	// Stop loading batches once the timeout elapses
+	ctx := context.Background()
+	if c.validatorStatusTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.validatorStatusTimeout)
+		defer cancel()
+	}
+
 	// Load validator data in batches & return
 	data := make([]Validator, 0, len(pubkeysOrIndices))
-	for bsi := 0; bsi < len(pubkeysOrIndices); bsi += MaxRequestValidatorsCount {
+	for bsi := 0; bsi < len(pubkeysOrIndices); bsi += c.validatorStatusBatchSize {
 
 		// Get batch start & end index
 		vsi := bsi
-		vei := bsi + MaxRequestValidatorsCount
+		vei := bsi + c.validatorStatusBatchSize
 		if vei > len(pubkeysOrIndices) {
 			vei = len(pubkeysOrIndices)
 		}
@@ -708,8 +751,12 @@ func (c *StandardHttpClient) getValidatorsByOpts(pubkeysOrIndices []string, opts
 		}
 
 		// Get & add validators
-		validators, err := c.getValidators(stateId, batch)
+		validators, err := c.getValidators(ctx, stateId, batch)
 		if err != nil {
+			if ctx.Err() == context.DeadlineExceeded {
+				// Return what was loaded so far; the underlying error isn't wrapped so this isn't mistaken for a disconnected client
+				return ValidatorsResponse{Data: data}, fmt.Errorf("Timed out after %s getting validator statuses; only %d of %d were retrieved", c.validatorStatusTimeout, len(data), len(pubkeysOrIndices))
+			}
 			return ValidatorsResponse{}, err
 		}
 		data = append(data, validators.Data...)
@@ -791,9 +838,19 @@ func (c *StandardHttpClient) getCommittees(stateId string, epoch *uint64) (Commi
 
 // Make a GET request to the beacon node
 func (c *StandardHttpClient) getRequest(requestPath string) ([]byte, int, error) {
+	return c.getRequestWithContext(context.Background(), requestPath)
+}
+
+// Make a GET request to the beacon node, aborting it if the context is cancelled
+func (c *StandardHttpClient) getRequestWithContext(ctx context.Context, requestPath string) ([]byte, int, error) {
 
 	// Send request
-	response, err := http.Get(fmt.Sprintf(RequestUrlFormat, c.providerAddress, requestPath))
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf(RequestUrlFormat, c.providerAddress, requestPath), nil)
+	if err != nil {
+		return []byte{}, 0, err
+	}
+	c.addHeaders(request)
+	response, err := http.DefaultClient.Do(request)
 	if err != nil {
 		return []byte{}, 0, err
 	}
@@ -823,7 +880,13 @@ func (c *StandardHttpClient) postRequest(requestPath string, requestBody interfa
 	requestBodyReader := bytes.NewReader(requestBodyBytes)
 
 	// Send request
-	response, err := http.Post(fmt.Sprintf(RequestUrlFormat, c.providerAddress, requestPath), RequestContentType, requestBodyReader)
+	request, err := http.NewRequest(http.MethodPost, fmt.Sprintf(RequestUrlFormat, c.providerAddress, requestPath), requestBodyReader)
+	if err != nil {
+		return []byte{}, 0, err
+	}
+	request.Header.Set("Content-Type", RequestContentType)
+	c.addHeaders(request)
+	response, err := http.DefaultClient.Do(request)
 	if err != nil {
 		return []byte{}, 0, err
 	}
@@ -841,3 +904,10 @@ func (c *StandardHttpClient) postRequest(requestPath string, requestBody interfa
 	return body, response.StatusCode, nil
 
 }
+
+// Add the custom headers to a request
+func (c *StandardHttpClient) addHeaders(request *http.Request) {
+	for name, value := range c.headers {
+		request.Header.Set(name, value)
+	}
+}
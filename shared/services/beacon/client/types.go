@@ -83,13 +83,20 @@ type BeaconBlockResponse struct {
 				} `json:"eth1_data"`
 				Attestations     []Attestation `json:"attestations"`
 				ExecutionPayload *struct {
-					FeeRecipient byteArray `json:"fee_recipient"`
-					BlockNumber  uinteger  `json:"block_number"`
+					FeeRecipient byteArray    `json:"fee_recipient"`
+					BlockNumber  uinteger     `json:"block_number"`
+					Withdrawals  []Withdrawal `json:"withdrawals"`
 				} `json:"execution_payload"`
 			} `json:"body"`
 		} `json:"message"`
 	} `json:"data"`
 }
+type Withdrawal struct {
+	Index          uinteger  `json:"index"`
+	ValidatorIndex uinteger  `json:"validator_index"`
+	Address        byteArray `json:"address"`
+	Amount         uinteger  `json:"amount"`
+}
 type ValidatorsResponse struct {
 	Data []Validator `json:"data"`
 }
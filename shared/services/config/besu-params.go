@@ -104,6 +104,7 @@ func NewBesuConfig(cfg *RocketPoolConfig) *BesuConfig {
 				config.Network_Prater:  besuTagTest,
 				config.Network_Kiln:    besuTagTest,
 				config.Network_Ropsten: besuTagTest,
+				config.Network_Custom:  besuTagTest,
 			},
 			AffectsContainers:    []config.ContainerID{config.ContainerID_Eth1},
 			EnvironmentVariables: []string{"EC_CONTAINER_TAG"},
@@ -158,6 +158,7 @@ func NewExternalLighthouseConfig(cfg *RocketPoolConfig) *ExternalLighthouseConfi
 				config.Network_Prater:  lighthouseTagTest,
 				config.Network_Kiln:    lighthouseTagTest,
 				config.Network_Ropsten: lighthouseTagTest,
+				config.Network_Custom:  lighthouseTagTest,
 			},
 			AffectsContainers:    []config.ContainerID{config.ContainerID_Validator},
 			EnvironmentVariables: []string{"VC_CONTAINER_TAG"},
@@ -243,6 +244,7 @@ func NewExternalPrysmConfig(cfg *RocketPoolConfig) *ExternalPrysmConfig {
 				config.Network_Prater:  getPrysmVcTestTag(),
 				config.Network_Kiln:    getPrysmVcTestTag(),
 				config.Network_Ropsten: getPrysmVcTestTag(),
+				config.Network_Custom:  getPrysmVcTestTag(),
 			},
 			AffectsContainers:    []config.ContainerID{config.ContainerID_Validator},
 			EnvironmentVariables: []string{"VC_CONTAINER_TAG"},
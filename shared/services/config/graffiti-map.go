@@ -0,0 +1,166 @@
+package config
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/rocket-pool/smartnode/shared/types/config"
+	hexutil "github.com/rocket-pool/smartnode/shared/utils/hex"
+	"gopkg.in/yaml.v2"
+)
+
+// The maximum length of a block's graffiti, in bytes
+const MaxGraffitiLength int = 32
+
+// The length of a validator pubkey, in bytes
+const validatorPubkeyLength int = 48
+
+// The folder the validators directory is mounted to in the validator client container
+const validatorContainerFolder string = "/validators"
+
+// A map of validator pubkeys to the graffiti they should use when proposing blocks
+type GraffitiMap struct {
+	// The graffiti to use for validators that aren't in the map; if blank, the validator client's normal graffiti is used
+	Default string `yaml:"default,omitempty"`
+
+	// The graffiti for each validator, keyed by pubkey
+	Validators map[string]string `yaml:"validators,omitempty"`
+}
+
+// Load the graffiti map from the provided path, returning nil if it doesn't exist
+func LoadGraffitiMap(path string) (*GraffitiMap, error) {
+	bytes, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading graffiti map [%s]: %w", path, err)
+	}
+
+	graffitiMap := new(GraffitiMap)
+	err = yaml.Unmarshal(bytes, graffitiMap)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing graffiti map [%s]: %w", path, err)
+	}
+	err = graffitiMap.normalize()
+	if err != nil {
+		return nil, fmt.Errorf("invalid graffiti map [%s]: %w", path, err)
+	}
+	return graffitiMap, nil
+}
+
+// Validate the graffiti map and convert its pubkeys to lowercase, 0x-prefixed hex strings
+func (m *GraffitiMap) normalize() error {
+	if len(m.Default) > MaxGraffitiLength {
+		return fmt.Errorf("the default graffiti is %d bytes long, but graffiti can be at most %d bytes", len(m.Default), MaxGraffitiLength)
+	}
+
+	validators := make(map[string]string, len(m.Validators))
+	for pubkey, graffiti := range m.Validators {
+		pubkeyBytes, err := hex.DecodeString(hexutil.RemovePrefix(strings.TrimSpace(pubkey)))
+		if err != nil || len(pubkeyBytes) != validatorPubkeyLength {
+			return fmt.Errorf("%s is not a valid validator pubkey", pubkey)
+		}
+		if len(graffiti) > MaxGraffitiLength {
+			return fmt.Errorf("the graffiti for validator %s is %d bytes long, but graffiti can be at most %d bytes", pubkey, len(graffiti), MaxGraffitiLength)
+		}
+		validators[hexutil.AddPrefix(hex.EncodeToString(pubkeyBytes))] = graffiti
+	}
+	m.Validators = validators
+	return nil
+}
+
+// Serialize the graffiti map into the graffiti file format of the provided validator client
+func (m *GraffitiMap) GetValidatorGraffitiFile(client config.ConsensusClient) ([]byte, error) {
+
+	// Sort the pubkeys so the file is always generated the same way
+	pubkeys := make([]string, 0, len(m.Validators))
+	for pubkey := range m.Validators {
+		pubkeys = append(pubkeys, pubkey)
+	}
+	sort.Strings(pubkeys)
+
+	switch client {
+	case config.ConsensusClient_Lighthouse:
+		// One "key: graffiti" line per validator; the graffiti runs to the end of the line
+		var builder strings.Builder
+		if m.Default != "" {
+			builder.WriteString(fmt.Sprintf("default: %s\n", m.Default))
+		}
+		for _, pubkey := range pubkeys {
+			builder.WriteString(fmt.Sprintf("%s: %s\n", pubkey, m.Validators[pubkey]))
+		}
+		return []byte(builder.String()), nil
+
+	default:
+		return nil, fmt.Errorf("per-validator graffiti is not supported by %s", client)
+	}
+
+}
+
+// Generate the validator client's graffiti file at filePath from the graffiti map at mapPath, or remove it if there is no usable map
+func WriteValidatorGraffitiFile(mapPath string, filePath string, client config.ConsensusClient) error {
+
+	// Remove any stale graffiti file first so the validator client goes back to its normal graffiti if the map can't be used
+	err := os.Remove(filePath)
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("could not remove old graffiti file %s: %w", filePath, err)
+	}
+
+	graffitiMap, err := LoadGraffitiMap(mapPath)
+	if err != nil {
+		return err
+	}
+	if graffitiMap == nil {
+		return nil
+	}
+
+	// Serialize the map in the format the validator client expects
+	graffitiFileContents, err := graffitiMap.GetValidatorGraffitiFile(client)
+	if err != nil {
+		return fmt.Errorf("%w, so the graffiti map at %s will be ignored", err, mapPath)
+	}
+
+	// Make sure the validators dir is created
+	err = os.MkdirAll(filepath.Dir(filePath), 0755)
+	if err != nil {
+		return fmt.Errorf("could not create validators directory: %w", err)
+	}
+	err = ioutil.WriteFile(filePath, graffitiFileContents, 0664)
+	if err != nil {
+		return fmt.Errorf("could not write graffiti file to %s: %w", filePath, err)
+	}
+
+	return nil
+
+}
+
+// Generate the validator client's graffiti file on the host, so the flag that loads it can be added in the same step
+func (cfg *RocketPoolConfig) DeployValidatorGraffitiFile() error {
+	consensusClient, _ := cfg.GetSelectedConsensusClient()
+	mapPath := filepath.Join(cfg.Smartnode.DataPath.Value.(string), GraffitiMapFilename)
+	return WriteValidatorGraffitiFile(mapPath, cfg.getValidatorGraffitiFileHostPath(), consensusClient)
+}
+
+// Get the path of the generated graffiti file on the host
+func (cfg *RocketPoolConfig) getValidatorGraffitiFileHostPath() string {
+	return filepath.Join(cfg.Smartnode.DataPath.Value.(string), "validators", ValidatorGraffitiFilename)
+}
+
+// Get the validator client flag that loads the generated graffiti file, or a blank string if there isn't one
+func (cfg *RocketPoolConfig) getValidatorGraffitiFileFlag(client config.ConsensusClient) string {
+	if client != config.ConsensusClient_Lighthouse {
+		return ""
+	}
+
+	// The file is only present once a valid graffiti map has been provided
+	if _, err := os.Stat(cfg.getValidatorGraffitiFileHostPath()); err != nil {
+		return ""
+	}
+	return fmt.Sprintf("--graffiti-file=%s/%s", validatorContainerFolder, ValidatorGraffitiFilename)
+}
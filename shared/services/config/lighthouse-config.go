@@ -57,6 +57,7 @@ func NewLighthouseConfig(cfg *RocketPoolConfig) *LighthouseConfig {
 				config.Network_Prater:  lighthouseTagTest,
 				config.Network_Kiln:    lighthouseTagTest,
 				config.Network_Ropsten: lighthouseTagTest,
+				config.Network_Custom:  lighthouseTagTest,
 			},
 			AffectsContainers:    []config.ContainerID{config.ContainerID_Eth2, config.ContainerID_Validator},
 			EnvironmentVariables: []string{"BN_CONTAINER_TAG", "VC_CONTAINER_TAG"},
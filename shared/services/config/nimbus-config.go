@@ -57,6 +57,7 @@ func NewNimbusConfig(cfg *RocketPoolConfig) *NimbusConfig {
 				config.Network_Prater:  nimbusTagTest,
 				config.Network_Kiln:    nimbusTagTest,
 				config.Network_Ropsten: nimbusTagTest,
+				config.Network_Custom:  nimbusTagTest,
 			},
 			AffectsContainers:    []config.ContainerID{config.ContainerID_Eth2, config.ContainerID_Validator},
 			EnvironmentVariables: []string{"BN_CONTAINER_TAG", "VC_CONTAINER_TAG"},
@@ -103,6 +103,7 @@ func NewPrysmConfig(cfg *RocketPoolConfig) *PrysmConfig {
 				config.Network_Prater:  getPrysmBnTestTag(),
 				config.Network_Kiln:    getPrysmBnTestTag(),
 				config.Network_Ropsten: getPrysmBnTestTag(),
+				config.Network_Custom:  getPrysmBnTestTag(),
 			},
 			AffectsContainers:    []config.ContainerID{config.ContainerID_Eth2},
 			EnvironmentVariables: []string{"BN_CONTAINER_TAG"},
@@ -120,6 +121,7 @@ func NewPrysmConfig(cfg *RocketPoolConfig) *PrysmConfig {
 				config.Network_Prater:  getPrysmVcTestTag(),
 				config.Network_Kiln:    getPrysmVcTestTag(),
 				config.Network_Ropsten: getPrysmVcTestTag(),
+				config.Network_Custom:  getPrysmVcTestTag(),
 			},
 			AffectsContainers:    []config.ContainerID{config.ContainerID_Validator},
 			EnvironmentVariables: []string{"VC_CONTAINER_TAG"},
@@ -11,6 +11,7 @@ import (
 	"strings"
 
 	"github.com/alessio/shellescape"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/pbnjay/memory"
 	"github.com/rocket-pool/smartnode/addons"
 	"github.com/rocket-pool/smartnode/shared"
@@ -36,8 +37,10 @@ const (
 	ValidatorContainerName    string = "validator"
 	WatchtowerContainerName   string = "watchtower"
 
-	FeeRecipientFileEnvVar string = "FEE_RECIPIENT_FILE"
-	FeeRecipientEnvVar     string = "FEE_RECIPIENT"
+	FeeRecipientFileEnvVar  string = "FEE_RECIPIENT_FILE"
+	FeeRecipientEnvVar      string = "FEE_RECIPIENT"
+	GraffitiFileEnvVar      string = "GRAFFITI_FILE"
+	RewardsTreeFolderEnvVar string = "ROCKETPOOL_REWARDS_TREE_FOLDER"
 )
 
 // Defaults
@@ -63,8 +66,9 @@ type RocketPoolConfig struct {
 	ExecutionClient     config.Parameter `yaml:"executionClient,omitempty"`
 
 	// Fallback settings
-	UseFallbackClients config.Parameter `yaml:"useFallbackClients,omitempty"`
-	ReconnectDelay     config.Parameter `yaml:"reconnectDelay,omitempty"`
+	UseFallbackClients     config.Parameter `yaml:"useFallbackClients,omitempty"`
+	ReconnectDelay         config.Parameter `yaml:"reconnectDelay,omitempty"`
+	FallbackPromotionDelay config.Parameter `yaml:"fallbackPromotionDelay,omitempty"`
 
 	// Consensus client settings
 	ConsensusClientMode     config.Parameter `yaml:"consensusClientMode,omitempty"`
@@ -235,6 +239,18 @@ func NewRocketPoolConfig(rpDir string, isNativeMode bool) *RocketPoolConfig {
 			OverwriteOnUpgrade:   false,
 		},
 
+		FallbackPromotionDelay: config.Parameter{
+			ID:                   "fallbackPromotionDelay",
+			Name:                 "Fallback Promotion Delay",
+			Description:          "If your primary Execution or Consensus client has been offline for this long, the Smartnode's daemons will promote the fallback client to primary so they stop paying the cost of health-checking the primary before every task. The original primary is only re-checked once per Reconnect Delay while demoted, and is restored as soon as it is working and synced again. An example format is \"10h20m30s\" - this would make it 10 hours, 20 minutes, and 30 seconds.\n\nLeave this blank or set it to \"0s\" to disable promotion.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node, config.ContainerID_Watchtower},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           true,
+			OverwriteOnUpgrade:   false,
+		},
+
 		ConsensusClientMode: config.Parameter{
 			ID:                   "consensusClientMode",
 			Name:                 "Consensus Client Mode",
@@ -502,6 +518,7 @@ func (cfg *RocketPoolConfig) GetParameters() []*config.Parameter {
 		&cfg.ExecutionClient,
 		&cfg.UseFallbackClients,
 		&cfg.ReconnectDelay,
+		&cfg.FallbackPromotionDelay,
 		&cfg.ConsensusClientMode,
 		&cfg.ConsensusClient,
 		&cfg.ExternalConsensusClient,
@@ -791,7 +808,9 @@ func (cfg *RocketPoolConfig) GenerateEnvironmentVariables() map[string]string {
 	envVars["SMARTNODE_IMAGE"] = cfg.Smartnode.GetSmartnodeContainerTag()
 	envVars["ROCKETPOOL_FOLDER"] = cfg.RocketPoolDirectory
 	envVars["RETH_ADDRESS"] = cfg.Smartnode.GetRethAddress().Hex()
-	envVars[FeeRecipientFileEnvVar] = FeeRecipientFilename // If this is running, we're in Docker mode by definition so use the Docker fee recipient filename
+	envVars[FeeRecipientFileEnvVar] = FeeRecipientFilename                   // If this is running, we're in Docker mode by definition so use the Docker fee recipient filename
+	envVars[GraffitiFileEnvVar] = ValidatorGraffitiFilename                  // Only present in the validators folder if a graffiti map has been provided
+	envVars[RewardsTreeFolderEnvVar] = cfg.Smartnode.GetRewardsTreesFolder() // Mounted to /.rocketpool/rewards-trees when the override is set
 	config.AddParametersToEnvVars(cfg.Smartnode.GetParameters(), envVars)
 	config.AddParametersToEnvVars(cfg.GetParameters(), envVars)
 
@@ -882,6 +901,11 @@ func (cfg *RocketPoolConfig) GenerateEnvironmentVariables() map[string]string {
 	}
 	envVars["CC_CLIENT"] = fmt.Sprint(consensusClient)
 
+	// Point the validator client at the per-validator graffiti file if the node daemon has generated one
+	if graffitiFileFlag := cfg.getValidatorGraffitiFileFlag(consensusClient); graffitiFileFlag != "" {
+		envVars["VC_ADDITIONAL_FLAGS"] = strings.TrimSpace(fmt.Sprintf("%s %s", envVars["VC_ADDITIONAL_FLAGS"], graffitiFileFlag))
+	}
+
 	// Graffiti
 	identifier := ""
 	versionString := fmt.Sprintf("v%s", shared.RocketPoolVersion)
@@ -1068,6 +1092,29 @@ func (cfg *RocketPoolConfig) Validate() []string {
 		errors = append(errors, "You are using an externally-managed Execution client and a locally-managed Consensus client.\nThis configuration is not compatible with The Merge; please select either locally-managed or externally-managed for both the EC and CC.")
 	}
 
+	// Custom networks can't use the built-in clients and need their own chain ID and contract addresses
+	if cfg.Smartnode.Network.Value.(config.Network) == config.Network_Custom {
+		if cfg.ExecutionClientMode.Value.(config.Mode) != config.Mode_External || cfg.ConsensusClientMode.Value.(config.Mode) != config.Mode_External {
+			errors = append(errors, "You are using the Custom Network, which requires externally-managed Execution and Consensus clients that are already connected to that network. Please set both clients to externally-managed mode.")
+		}
+		if cfg.Smartnode.CustomChainID.Value.(uint64) == 0 {
+			errors = append(errors, "You are using the Custom Network but haven't set its chain ID. Please enter it in the Smartnode settings.")
+		}
+		for _, param := range []*config.Parameter{&cfg.Smartnode.CustomStorageAddress, &cfg.Smartnode.CustomRplTokenAddress, &cfg.Smartnode.CustomRethAddress} {
+			if !common.IsHexAddress(param.Value.(string)) {
+				errors = append(errors, fmt.Sprintf("You are using the Custom Network but [%s] is not a valid address. Please enter it in the Smartnode settings.", param.Name))
+			}
+		}
+		if cfg.Smartnode.CustomRplTestPrice.Value.(float64) < 0 {
+			errors = append(errors, fmt.Sprintf("[%s] cannot be negative.", cfg.Smartnode.CustomRplTestPrice.Name))
+		}
+	}
+
+	// The genesis fork version override is only for custom test networks
+	if cfg.Smartnode.Network.Value.(config.Network) != config.Network_Custom && cfg.Smartnode.GenesisForkVersionOverride.Value.(string) != "" {
+		errors = append(errors, fmt.Sprintf("[%s] can only be used on the Custom Network. Please clear it in the Smartnode settings.", cfg.Smartnode.GenesisForkVersionOverride.Name))
+	}
+
 	// Ensure there's a MEV-boost URL
 	if cfg.EnableMevBoost.Value == true {
 		switch cfg.MevBoost.Mode.Value.(config.Mode) {
@@ -3,6 +3,7 @@ package config
 import (
 	"fmt"
 	"path/filepath"
+	"strings"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/rocket-pool/smartnode/shared"
@@ -19,17 +20,28 @@ const (
 	SnapshotID                         string = "rocketpool-dao.eth"
 	RewardsTreeFilenameFormat          string = "rp-rewards-%s-%d.json"
 	MinipoolPerformanceFilenameFormat  string = "rp-minipool-performance-%s-%d.json"
+	RewardsCheckpointFilenameFormat    string = "rp-rewards-checkpoint-%s-%d.json"
 	RewardsTreeIpfsExtension           string = ".zst"
 	RewardsTreesFolder                 string = "rewards-trees"
 	DaemonDataPath                     string = "/.rocketpool/data"
+	DaemonRewardsTreesPath             string = "/.rocketpool/rewards-trees"
 	WatchtowerFolder                   string = "watchtower"
+	TransferFolder                     string = "transfer"
 	WatchtowerStateFile                string = "state.yml"
+	IdempotencyCacheFile               string = "idempotency-cache.json"
+	ValidatorStatesFile                string = "validator-states.json"
+	ValidatorKeyExportLogFile          string = "validator-key-exports.log"
 	RegenerateRewardsTreeRequestSuffix string = ".request"
 	RegenerateRewardsTreeRequestFormat string = "%d" + RegenerateRewardsTreeRequestSuffix
+	DryRunRewardsTreeRequestSuffix     string = ".dry-run" + RegenerateRewardsTreeRequestSuffix
+	DryRunRewardsTreeRequestFormat     string = "%d" + DryRunRewardsTreeRequestSuffix
+	DryRunRewardsTreeFolderPattern     string = "dry-run-%d-*"
 	PrimaryRewardsFileUrl              string = "https://%s.ipfs.dweb.link/%s"
 	SecondaryRewardsFileUrl            string = "https://ipfs.io/ipfs/%s/%s"
 	FeeRecipientFilename               string = "rp-fee-recipient.txt"
 	NativeFeeRecipientFilename         string = "rp-fee-recipient-env.txt"
+	GraffitiMapFilename                string = "graffiti-map.yml"
+	ValidatorGraffitiFilename          string = "rp-graffiti.txt"
 )
 
 // Defaults
@@ -67,15 +79,117 @@ type SmartnodeConfig struct {
 	// Threshold for auto minipool stakes
 	MinipoolStakeGasThreshold config.Parameter `yaml:"minipoolStakeGasThreshold,omitempty"`
 
+	// The minimum ETH balance to keep in the node wallet for automated transactions
+	MinimumEthReserve config.Parameter `yaml:"minimumEthReserve,omitempty"`
+
 	// Mode for acquiring Merkle rewards trees
 	RewardsTreeMode config.Parameter `yaml:"rewardsTreeMode,omitempty"`
 
+	// Override for the folder that rewards trees and minipool performance files are stored in
+	RewardsTreePath config.Parameter `yaml:"rewardsTreePath,omitempty"`
+
 	// URL for an EC with archive mode, for manual rewards tree generation
 	ArchiveECUrl config.Parameter `yaml:"archiveEcUrl,omitempty"`
 
+	// The number of epochs between checkpoints of the rewards tree generator's attestation check
+	RewardsTreeCheckpointInterval config.Parameter `yaml:"rewardsTreeCheckpointInterval,omitempty"`
+
+	// Toggle for the watchtower to generate each interval's rewards tree without uploading or submitting it
+	RewardsTreeDryRun config.Parameter `yaml:"rewardsTreeDryRun,omitempty"`
+
+	// The service Oracle DAO members use to upload Merkle trees to IPFS
+	TreeUploadService config.Parameter `yaml:"treeUploadService,omitempty"`
+
 	// Token for Oracle DAO members to use when uploading Merkle trees to Web3.Storage
 	Web3StorageApiToken config.Parameter `yaml:"web3StorageApiToken,omitempty"`
 
+	// URL of the IPFS HTTP API for Oracle DAO members to use when uploading Merkle trees to their own IPFS node
+	IpfsApiUrl config.Parameter `yaml:"ipfsApiUrl,omitempty"`
+
+	// The number of times to try uploading a rewards file before giving up
+	TreeUploadAttempts config.Parameter `yaml:"treeUploadAttempts,omitempty"`
+
+	// The delay before the first rewards file upload retry, doubled on each subsequent retry
+	TreeUploadRetryDelay config.Parameter `yaml:"treeUploadRetryDelay,omitempty"`
+
+	// Toggle for Oracle DAO members to automatically execute proposals that have passed
+	AutoExecuteOdaoProposals config.Parameter `yaml:"autoExecuteOdaoProposals,omitempty"`
+
+	// The delay per Oracle DAO member index before submitting RPL prices and rewards trees
+	OdaoSubmissionStagger config.Parameter `yaml:"odaoSubmissionStagger,omitempty"`
+
+	// The interval after which Oracle DAO members refresh L2 rates even if the messengers don't report them as stale
+	L2RateRefreshInterval config.Parameter `yaml:"l2RateRefreshInterval,omitempty"`
+
+	// Toggle for writing the watchtower's logs as JSON instead of colored text
+	WatchtowerJsonLogs config.Parameter `yaml:"watchtowerJsonLogs,omitempty"`
+
+	// The number of blocks the daemons wait for on top of a transaction's block before treating it as successful
+	TxConfirmationBlocks config.Parameter `yaml:"txConfirmationBlocks,omitempty"`
+
+	// Toggle for checking the configured chain ID against the Execution client's before signing transactions
+	VerifyChainID config.Parameter `yaml:"verifyChainId,omitempty"`
+
+	// The number of validators to request from the Beacon client at a time when getting validator statuses
+	ValidatorStatusBatchSize config.Parameter `yaml:"validatorStatusBatchSize,omitempty"`
+
+	// How long to wait for the Beacon client to return validator statuses before giving up
+	ValidatorStatusTimeout config.Parameter `yaml:"validatorStatusTimeout,omitempty"`
+
+	// Extra HTTP headers to send with every request to the Beacon Node
+	BeaconApiHeaders config.Parameter `yaml:"beaconApiHeaders,omitempty"`
+
+	// Bearer token to send with every request to the Beacon Node
+	BeaconApiToken config.Parameter `yaml:"beaconApiToken,omitempty"`
+
+	// Extra HTTP headers to send with every request to the fallback Beacon Node
+	FallbackBeaconApiHeaders config.Parameter `yaml:"fallbackBeaconApiHeaders,omitempty"`
+
+	// Bearer token to send with every request to the fallback Beacon Node
+	FallbackBeaconApiToken config.Parameter `yaml:"fallbackBeaconApiToken,omitempty"`
+
+	// Override for the genesis fork version used when creating and validating deposits
+	GenesisForkVersionOverride config.Parameter `yaml:"genesisForkVersionOverride,omitempty"`
+
+	// Webhook to notify when one of the node's validators changes state on the Beacon Chain
+	ValidatorStateWebhookUrl config.Parameter `yaml:"validatorStateWebhookUrl,omitempty"`
+
+	// How often to check the node's validators for slashings
+	SlashingAlertInterval config.Parameter `yaml:"slashingAlertInterval,omitempty"`
+
+	// The URL to notify when one of the node's validators is slashed
+	SlashingAlertWebhookUrl config.Parameter `yaml:"slashingAlertWebhookUrl,omitempty"`
+
+	// Whether or not to stop the validator client when one of the node's validators is slashed
+	SlashingAlertStopValidator config.Parameter `yaml:"slashingAlertStopValidator,omitempty"`
+
+	// The chain ID of the custom network
+	CustomChainID config.Parameter `yaml:"customChainId,omitempty"`
+
+	// The address of RocketStorage on the custom network
+	CustomStorageAddress config.Parameter `yaml:"customStorageAddress,omitempty"`
+
+	// The address of the 1inch oracle on the custom network
+	CustomOneInchOracleAddress config.Parameter `yaml:"customOneInchOracleAddress,omitempty"`
+
+	// The address of the RPL token on the custom network
+	CustomRplTokenAddress config.Parameter `yaml:"customRplTokenAddress,omitempty"`
+
+	// The address of the RPL faucet on the custom network
+	CustomRplFaucetAddress config.Parameter `yaml:"customRplFaucetAddress,omitempty"`
+
+	// The address of rETH on the custom network
+	CustomRethAddress config.Parameter `yaml:"customRethAddress,omitempty"`
+
+	// The address of the Optimism price messenger on the custom network
+	CustomOptimismMessengerAddress config.Parameter `yaml:"customOptimismMessengerAddress,omitempty"`
+
+	// The address of the Gnosis price messenger on the custom network
+	CustomGnosisMessengerAddress config.Parameter `yaml:"customGnosisMessengerAddress,omitempty"`
+
+	// A fixed RPL price for the Oracle DAO to submit on a custom network without a 1inch oracle
+	CustomRplTestPrice config.Parameter `yaml:"customRplTestPrice,omitempty"`
+
 	///////////////////////////
 	// Non-editable settings //
 	///////////////////////////
@@ -128,6 +242,9 @@ type SmartnodeConfig struct {
 	// The RocketOvmPriceMessenger address for each network
 	optimismPriceMessengerAddress map[config.Network]string `yaml:"-"`
 
+	// The RocketGnosisPriceMessenger address for each network
+	gnosisPriceMessengerAddress map[config.Network]string `yaml:"-"`
+
 	// Rewards submission block maps
 	rewardsSubmissionBlockMaps map[config.Network][]uint64 `yaml:"-"`
 }
@@ -202,7 +319,11 @@ func NewSmartnodeConfig(cfg *RocketPoolConfig) *SmartnodeConfig {
 					Name:        "Ropsten Testnet",
 					Description: "This is the Ropsten test network, which uses free \"test\" ETH and free \"test\" RPL.\n\nUse this if you want to practice running a node on a post-merge network to learn how it differs from Mainnet today.",
 					Value:       config.Network_Ropsten,
-				}*/},
+				}*/{
+					Name:        "Custom Network",
+					Description: "A Rocket Pool deployment on a network the Smartnode doesn't know about, such as a new testnet or devnet.\nThe chain ID and contract addresses are taken from the Custom Network settings below, and you must use externally-managed Execution and Consensus clients that are already connected to that network.",
+					Value:       config.Network_Custom,
+				}},
 		},
 
 		ManualMaxFee: config.Parameter{
@@ -242,6 +363,18 @@ func NewSmartnodeConfig(cfg *RocketPoolConfig) *SmartnodeConfig {
 			OverwriteOnUpgrade:   false,
 		},
 
+		MinimumEthReserve: config.Parameter{
+			ID:                   "minimumEthReserve",
+			Name:                 "Minimum ETH Reserve",
+			Description:          "The minimum amount of ETH (*not* gwei) that the Smartnode's automated tasks will leave in your node wallet. If an automatic transaction (such as staking a minipool or an Oracle DAO duty) could drop your node wallet's balance below this amount after paying for gas, it will not be submitted and a warning will be logged instead.\n\nThis keeps enough ETH on hand for manual, critical operations. A value of 0 disables the reserve.",
+			Type:                 config.ParameterType_Float,
+			Default:              map[config.Network]interface{}{config.Network_All: float64(0)},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node, config.ContainerID_Watchtower},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
 		RewardsTreeMode: config.Parameter{
 			ID:                   "rewardsTreeMode",
 			Name:                 "Rewards Tree Mode",
@@ -263,10 +396,46 @@ func NewSmartnodeConfig(cfg *RocketPoolConfig) *SmartnodeConfig {
 			}},
 		},
 
+		RewardsTreePath: config.Parameter{
+			ID:                   "rewardsTreePath",
+			Name:                 "Rewards Tree Path",
+			Description:          "The absolute path of the folder to store rewards tree and minipool performance files in, including their compressed versions. These files grow every interval, so you may want to put them on a larger drive than your `data` folder. You may use environment variables in this string.\n\nLeave this blank to keep them in the `rewards-trees` folder inside your `data` folder.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Api, config.ContainerID_Node, config.ContainerID_Watchtower},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           true,
+			OverwriteOnUpgrade:   false,
+		},
+
+		RewardsTreeCheckpointInterval: config.Parameter{
+			ID:                   "rewardsTreeCheckpointInterval",
+			Name:                 "Rewards Tree Checkpoint Interval",
+			Description:          "[orange]**For Oracle DAO members only.**\n\n[white]Checking every minipool's attestations is the slowest part of generating a rewards tree. Set this to have your watchtower save its progress every this many epochs, so if generation is interrupted (for example by running out of memory or losing the connection to a client) it can resume from the last checkpoint instead of starting over.\n\nSet this to 0 to disable checkpoints.",
+			Type:                 config.ParameterType_Uint,
+			Default:              map[config.Network]interface{}{config.Network_All: uint64(0)},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Watchtower},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		RewardsTreeDryRun: config.Parameter{
+			ID:                   "rewardsTreeDryRun",
+			Name:                 "Rewards Tree Dry Run",
+			Description:          "[orange]**For debugging only.**\n\n[white]Enable this to have your watchtower generate the rewards tree and minipool performance file at each rewards interval without uploading or submitting them. The files are saved to a new `dry-run` folder in your watchtower directory instead of replacing your rewards files, and the computed Merkle root is logged for comparison.\n\n[orange]Oracle DAO members will not submit the rewards tree while this is enabled.",
+			Type:                 config.ParameterType_Bool,
+			Default:              map[config.Network]interface{}{config.Network_All: false},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Watchtower},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
 		ArchiveECUrl: config.Parameter{
 			ID:                   "archiveECUrl",
 			Name:                 "Archive-Mode EC URL",
-			Description:          "[orange]**For manual Merkle rewards tree generation only.**[white]\n\nGenerating the Merkle rewards tree files for past rewards intervals typically requires an Execution client with Archive mode enabled, which is usually disabled on your primary and fallback Execution clients to save disk space.\nIf you want to generate your own rewards tree files for intervals from a long time ago, you may enter the URL of an Execution client with Archive access here.\n\nFor a free light client with Archive access, you may use https://www.alchemy.com/supernode.",
+			Description:          "[orange]**For manual Merkle rewards tree generation only.**[white]\n\nGenerating the Merkle rewards tree files for past rewards intervals typically requires an Execution client with Archive mode enabled, which is usually disabled on your primary and fallback Execution clients to save disk space.\nIf you want to generate your own rewards tree files for intervals from a long time ago, you may enter the URL of an Execution client with Archive access here.\n\nYou may enter multiple URLs separated by commas; they will be tried in order until one of them can provide the state for the requested block.\n\nFor a free light client with Archive access, you may use https://www.alchemy.com/supernode.",
 			Type:                 config.ParameterType_String,
 			Default:              map[config.Network]interface{}{config.Network_All: ""},
 			AffectsContainers:    []config.ContainerID{config.ContainerID_Watchtower},
@@ -275,6 +444,27 @@ func NewSmartnodeConfig(cfg *RocketPoolConfig) *SmartnodeConfig {
 			OverwriteOnUpgrade:   false,
 		},
 
+		TreeUploadService: config.Parameter{
+			ID:                   "treeUploadService",
+			Name:                 "Rewards Tree Upload Service",
+			Description:          "[orange]**For Oracle DAO members only.**\n\n[white]Select the service your watchtower uses to upload Merkle rewards trees to IPFS at each rewards interval.\n\n[orange]The rewards tree's CID is part of your submission, so every Oracle DAO member has to produce the same one. The IPFS Node option uses Web3.Storage's chunking settings and checks that your node produces a known CID before uploading, but only use it if your node passes that check.",
+			Type:                 config.ParameterType_Choice,
+			Default:              map[config.Network]interface{}{config.Network_All: config.TreeUploadService_Web3Storage},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Watchtower},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+			Options: []config.ParameterOption{{
+				Name:        "Web3.Storage",
+				Description: "Upload rewards trees to https://web3.storage/ using your Web3.Storage API token.",
+				Value:       config.TreeUploadService_Web3Storage,
+			}, {
+				Name:        "IPFS Node",
+				Description: "Upload rewards trees to your own IPFS node, or to any pinning service that supports the IPFS HTTP API, using the IPFS API URL. The node must honor the chunker, raw-leaves, and CID version options of the add route so its CIDs match Web3.Storage's.",
+				Value:       config.TreeUploadService_Ipfs,
+			}},
+		},
+
 		Web3StorageApiToken: config.Parameter{
 			ID:                   "web3StorageApiToken",
 			Name:                 "Web3.Storage API Token",
@@ -287,6 +477,354 @@ func NewSmartnodeConfig(cfg *RocketPoolConfig) *SmartnodeConfig {
 			OverwriteOnUpgrade:   false,
 		},
 
+		IpfsApiUrl: config.Parameter{
+			ID:                   "ipfsApiUrl",
+			Name:                 "IPFS API URL",
+			Description:          "[orange]**For Oracle DAO members only.**\n\n[white]The URL of the IPFS HTTP API to upload Merkle rewards trees to when the Rewards Tree Upload Service is set to IPFS Node, for example http://127.0.0.1:5001. Uploaded files are pinned on that node.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Watchtower},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           true,
+			OverwriteOnUpgrade:   false,
+		},
+
+		TreeUploadAttempts: config.Parameter{
+			ID:                   "treeUploadAttempts",
+			Name:                 "Tree Upload Attempts",
+			Description:          "[orange]**For Oracle DAO members only.**\n\n[white]The number of times your watchtower will try to upload a Merkle rewards tree with the selected upload service before giving up on the submission. Failed uploads (such as timeouts during IPFS congestion) are retried with an exponential backoff.",
+			Type:                 config.ParameterType_Uint,
+			Default:              map[config.Network]interface{}{config.Network_All: uint64(3)},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Watchtower},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		TreeUploadRetryDelay: config.Parameter{
+			ID:                   "treeUploadRetryDelay",
+			Name:                 "Tree Upload Retry Delay",
+			Description:          "[orange]**For Oracle DAO members only.**\n\n[white]How long your watchtower will wait before retrying a failed rewards tree upload. This delay doubles after each failed attempt. An example format is \"10s\" - this would make it 10 seconds.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: "10s"},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Watchtower},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		AutoExecuteOdaoProposals: config.Parameter{
+			ID:                   "autoExecuteOdaoProposals",
+			Name:                 "Auto-Execute Oracle DAO Proposals",
+			Description:          "[orange]**For Oracle DAO members only.**\n\n[white]Enable this to have your watchtower automatically execute Oracle DAO proposals that have passed, so they don't stall waiting for someone to execute them manually.\n\nMembers that enable this take turns executing each proposal so they don't all pay to execute the same one. Execution is skipped while gas is above the watchtower's max fee.",
+			Type:                 config.ParameterType_Bool,
+			Default:              map[config.Network]interface{}{config.Network_All: false},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Watchtower},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		OdaoSubmissionStagger: config.Parameter{
+			ID:                   "odaoSubmissionStagger",
+			Name:                 "Oracle DAO Submission Stagger",
+			Description:          "[orange]**For Oracle DAO members only.**\n\n[white]How long your watchtower waits, per position in the Oracle DAO member list, before submitting RPL prices or a rewards tree. Lower-index members submit first, and your watchtower skips its submission if consensus has already been reached by the time its turn comes, which saves gas. An example format is \"30s\" - with this value, the third member would wait 1 minute.\n\nSet this to 0s to submit immediately.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: "0s"},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Watchtower},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		L2RateRefreshInterval: config.Parameter{
+			ID:                   "l2RateRefreshInterval",
+			Name:                 "L2 Rate Refresh Interval",
+			Description:          "[orange]**For Oracle DAO members only.**\n\n[white]By default, your watchtower only submits the RPL rate to an L2 price messenger when the messenger reports the rate as stale. Set this to have the Oracle DAO also refresh the rate once per interval, unless someone has already updated it during that interval. The refresh is sent by whichever member's turn it is when the interval starts, so every member should use the same value. An example format is \"12h\" - this would make it 12 hours.\n\nLeave this blank or set it to 0s to only use the messengers' staleness checks.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Watchtower},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           true,
+			OverwriteOnUpgrade:   false,
+		},
+
+		WatchtowerJsonLogs: config.Parameter{
+			ID:                   "watchtowerJsonLogs",
+			Name:                 "Watchtower JSON Logs",
+			Description:          "[orange]**For Oracle DAO members only.**\n\n[white]Enable this to have your watchtower write its logs as JSON objects with `ts`, `level`, `task`, and `msg` fields instead of colored text, so they can be ingested by log aggregators such as Loki or ELK.",
+			Type:                 config.ParameterType_Bool,
+			Default:              map[config.Network]interface{}{config.Network_All: false},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Watchtower},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		TxConfirmationBlocks: config.Parameter{
+			ID:                   "txConfirmationBlocks",
+			Name:                 "Transaction Confirmation Blocks",
+			Description:          "The number of blocks the node and watchtower daemons wait for on top of the block a transaction was included in before treating it as successful. After waiting, they check that the transaction is still on the chain, so a shallow reorg doesn't go unnoticed.\n\nSet this to 0 to treat transactions as successful as soon as they're included in a block.",
+			Type:                 config.ParameterType_Uint,
+			Default:              map[config.Network]interface{}{config.Network_All: uint64(0)},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node, config.ContainerID_Watchtower},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		VerifyChainID: config.Parameter{
+			ID:                   "verifyChainId",
+			Name:                 "Verify Chain ID",
+			Description:          "Enable this to have the Smartnode check that your Execution client is on the same chain as your Smartnode configuration before it signs any transactions. This catches mistakes like pointing a testnet node at a Mainnet Execution client before a transaction is ever sent.",
+			Type:                 config.ParameterType_Bool,
+			Default:              map[config.Network]interface{}{config.Network_All: true},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Api, config.ContainerID_Node, config.ContainerID_Watchtower},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		ValidatorStatusBatchSize: config.Parameter{
+			ID:                   "validatorStatusBatchSize",
+			Name:                 "Validator Status Batch Size",
+			Description:          "The number of validators the Smartnode asks your Consensus client about in a single request when it looks up validator statuses. Lower this if your Consensus client struggles with large requests.",
+			Type:                 config.ParameterType_Uint,
+			Default:              map[config.Network]interface{}{config.Network_All: uint64(600)},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Api, config.ContainerID_Node, config.ContainerID_Watchtower},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		ValidatorStatusTimeout: config.Parameter{
+			ID:                   "validatorStatusTimeout",
+			Name:                 "Validator Status Timeout",
+			Description:          "How long the Smartnode waits for your Consensus client to return validator statuses before giving up. When this runs out, commands report the statuses that were retrieved along with an error instead of hanging. An example format is \"1m30s\" - this would make it 1 minute and 30 seconds.\n\nSet this to 0s to wait indefinitely.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: "0s"},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Api, config.ContainerID_Node, config.ContainerID_Watchtower},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		BeaconApiHeaders: config.Parameter{
+			ID:                   "beaconApiHeaders",
+			Name:                 "Beacon API Headers",
+			Description:          "Extra HTTP headers the Smartnode should send with every request to your Consensus client, such as the API key header required by a hosted Beacon Node provider. Enter them as a comma-separated list of `Name: Value` pairs, for example \"X-Api-Key: abc123\". They are not sent to your fallback Consensus client.\n\nLeave this blank if your Consensus client doesn't need any.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Api, config.ContainerID_Node, config.ContainerID_Watchtower},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           true,
+			OverwriteOnUpgrade:   false,
+		},
+
+		BeaconApiToken: config.Parameter{
+			ID:                   "beaconApiToken",
+			Name:                 "Beacon API Token",
+			Description:          "A bearer token the Smartnode should send in the `Authorization` header of every request to your Consensus client, for hosted Beacon Node providers that use one. It is not sent to your fallback Consensus client.\n\nLeave this blank if your Consensus client doesn't need one.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Api, config.ContainerID_Node, config.ContainerID_Watchtower},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           true,
+			OverwriteOnUpgrade:   false,
+		},
+
+		FallbackBeaconApiHeaders: config.Parameter{
+			ID:                   "fallbackBeaconApiHeaders",
+			Name:                 "Fallback Beacon API Headers",
+			Description:          "Extra HTTP headers the Smartnode should send with every request to your fallback Consensus client, in the same format as the Beacon API Headers. These are kept separate so your primary provider's credentials are never sent to your fallback provider.\n\nLeave this blank if your fallback Consensus client doesn't need any.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Api, config.ContainerID_Node, config.ContainerID_Watchtower},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           true,
+			OverwriteOnUpgrade:   false,
+		},
+
+		FallbackBeaconApiToken: config.Parameter{
+			ID:                   "fallbackBeaconApiToken",
+			Name:                 "Fallback Beacon API Token",
+			Description:          "A bearer token the Smartnode should send in the `Authorization` header of every request to your fallback Consensus client.\n\nLeave this blank if your fallback Consensus client doesn't need one.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Api, config.ContainerID_Node, config.ContainerID_Watchtower},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           true,
+			OverwriteOnUpgrade:   false,
+		},
+
+		GenesisForkVersionOverride: config.Parameter{
+			ID:                   "genesisForkVersionOverride",
+			Name:                 "Genesis Fork Version Override",
+			Description:          "[red]**ADVANCED - FOR CUSTOM TEST NETWORKS ONLY.**\n\n[white]The genesis fork version (in hex, e.g. 0x00001020) to use when creating and validating validator deposits, instead of the one reported by your Beacon client. Only set this if your Beacon client reports the wrong value on a nonstandard network. It is only used on the Custom Network, and the Oracle DAO's scrub check never uses it.\n\n[orange]WARNING: Deposits signed with the wrong fork version are invalid and the ETH sent with them will be lost.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Api, config.ContainerID_Node, config.ContainerID_Watchtower},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           true,
+			OverwriteOnUpgrade:   false,
+		},
+
+		ValidatorStateWebhookUrl: config.Parameter{
+			ID:                   "validatorStateWebhookUrl",
+			Name:                 "Validator State Webhook URL",
+			Description:          "A URL that the node will send a JSON POST request to whenever one of your minipool validators changes state on the Beacon Chain (for example when it activates, starts exiting, becomes withdrawable, or is slashed).\n\nLeave this blank to disable validator state notifications.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           true,
+			OverwriteOnUpgrade:   false,
+		},
+
+		SlashingAlertInterval: config.Parameter{
+			ID:                   "slashingAlertInterval",
+			Name:                 "Slashing Alert Interval",
+			Description:          "How often the node should check if any of your minipool validators have been slashed. This check runs separately from (and should be much more frequent than) the regular validator state checks, so you can react to a slashing as quickly as possible. An example format is \"1m30s\" - this would make it 1 minute and 30 seconds.\n\nLeave this blank to disable slashing alerts.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           true,
+			OverwriteOnUpgrade:   false,
+		},
+
+		SlashingAlertWebhookUrl: config.Parameter{
+			ID:                   "slashingAlertWebhookUrl",
+			Name:                 "Slashing Alert Webhook URL",
+			Description:          "A URL that the node will send a high-priority JSON POST request to as soon as it detects that one of your minipool validators has been slashed.\n\nLeave this blank if you don't want slashing notifications.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           true,
+			OverwriteOnUpgrade:   false,
+		},
+
+		SlashingAlertStopValidator: config.Parameter{
+			ID:                   "slashingAlertStopValidator",
+			Name:                 "Stop Validator on Slashing",
+			Description:          "Enable this to have the node stop your Validator Client as soon as it detects that one of your minipool validators has been slashed, to prevent any further penalties from a misconfigured or duplicated setup.\n\n[orange]Your Validator Client will stay stopped for all of your validators until you investigate and start it again manually.",
+			Type:                 config.ParameterType_Bool,
+			Default:              map[config.Network]interface{}{config.Network_All: false},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		CustomChainID: config.Parameter{
+			ID:                   "customChainId",
+			Name:                 "Custom Network Chain ID",
+			Description:          "[orange]**For the Custom Network only.**\n\n[white]The chain ID of your custom network's execution layer.",
+			Type:                 config.ParameterType_Uint,
+			Default:              map[config.Network]interface{}{config.Network_All: uint64(0)},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Api, config.ContainerID_Node, config.ContainerID_Watchtower},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		CustomStorageAddress: config.Parameter{
+			ID:                   "customStorageAddress",
+			Name:                 "Custom Network RocketStorage Address",
+			Description:          "[orange]**For the Custom Network only.**\n\n[white]The address of the RocketStorage contract on your custom network.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Api, config.ContainerID_Node, config.ContainerID_Watchtower},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		CustomOneInchOracleAddress: config.Parameter{
+			ID:                   "customOneInchOracleAddress",
+			Name:                 "Custom Network 1inch Oracle Address",
+			Description:          "[orange]**For the Custom Network only.**\n\n[white]The address of the 1inch offchain oracle that the Oracle DAO uses for RPL price submissions on your custom network. Leave this blank if it isn't deployed on that network.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Api, config.ContainerID_Node, config.ContainerID_Watchtower},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           true,
+			OverwriteOnUpgrade:   false,
+		},
+
+		CustomRplTokenAddress: config.Parameter{
+			ID:                   "customRplTokenAddress",
+			Name:                 "Custom Network RPL Token Address",
+			Description:          "[orange]**For the Custom Network only.**\n\n[white]The address of the RPL token contract on your custom network.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Api, config.ContainerID_Node, config.ContainerID_Watchtower},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		CustomRplFaucetAddress: config.Parameter{
+			ID:                   "customRplFaucetAddress",
+			Name:                 "Custom Network RPL Faucet Address",
+			Description:          "[orange]**For the Custom Network only.**\n\n[white]The address of the RPL faucet contract on your custom network. Leave this blank if it isn't deployed on that network.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Api, config.ContainerID_Node, config.ContainerID_Watchtower},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           true,
+			OverwriteOnUpgrade:   false,
+		},
+
+		CustomRethAddress: config.Parameter{
+			ID:                   "customRethAddress",
+			Name:                 "Custom Network rETH Address",
+			Description:          "[orange]**For the Custom Network only.**\n\n[white]The address of the rETH token contract on your custom network.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Api, config.ContainerID_Node, config.ContainerID_Watchtower},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		CustomOptimismMessengerAddress: config.Parameter{
+			ID:                   "customOptimismMessengerAddress",
+			Name:                 "Custom Network Optimism Messenger Address",
+			Description:          "[orange]**For the Custom Network only.**\n\n[white]The address of the Optimism RPL price messenger on your custom network. Leave this blank if it isn't deployed on that network.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Api, config.ContainerID_Node, config.ContainerID_Watchtower},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           true,
+			OverwriteOnUpgrade:   false,
+		},
+
+		CustomGnosisMessengerAddress: config.Parameter{
+			ID:                   "customGnosisMessengerAddress",
+			Name:                 "Custom Network Gnosis Messenger Address",
+			Description:          "[orange]**For the Custom Network only.**\n\n[white]The address of the Gnosis RPL price messenger on your custom network. Leave this blank if it isn't deployed on that network.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Api, config.ContainerID_Node, config.ContainerID_Watchtower},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           true,
+			OverwriteOnUpgrade:   false,
+		},
+
+		CustomRplTestPrice: config.Parameter{
+			ID:                   "customRplTestPrice",
+			Name:                 "Custom Network Test RPL Price",
+			Description:          "[orange]**For the Custom Network only.**\n\n[white]A fixed RPL price (in ETH) for the Oracle DAO to submit when there is no 1inch oracle on your custom network, so the price submission process can be tested.\n\nThis is only used if the 1inch oracle address is blank. Leave it at 0 to disable it.",
+			Type:                 config.ParameterType_Float,
+			Default:              map[config.Network]interface{}{config.Network_All: float64(0)},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Watchtower},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
 		txWatchUrl: map[config.Network]string{
 			config.Network_Mainnet: "https://etherscan.io/tx",
 			config.Network_Prater:  "https://goerli.etherscan.io/tx",
@@ -408,6 +946,13 @@ func NewSmartnodeConfig(cfg *RocketPoolConfig) *SmartnodeConfig {
 			config.Network_Ropsten: "",
 		},
 
+		gnosisPriceMessengerAddress: map[config.Network]string{
+			config.Network_Mainnet: "",
+			config.Network_Prater:  "",
+			config.Network_Kiln:    "",
+			config.Network_Ropsten: "",
+		},
+
 		rewardsSubmissionBlockMaps: map[config.Network][]uint64{
 			config.Network_Mainnet: {
 				15451165,
@@ -449,9 +994,43 @@ func (cfg *SmartnodeConfig) GetParameters() []*config.Parameter {
 		&cfg.ManualMaxFee,
 		&cfg.PriorityFee,
 		&cfg.MinipoolStakeGasThreshold,
+		&cfg.MinimumEthReserve,
 		&cfg.RewardsTreeMode,
+		&cfg.RewardsTreePath,
+		&cfg.RewardsTreeCheckpointInterval,
+		&cfg.RewardsTreeDryRun,
 		&cfg.ArchiveECUrl,
+		&cfg.TreeUploadService,
 		&cfg.Web3StorageApiToken,
+		&cfg.IpfsApiUrl,
+		&cfg.TreeUploadAttempts,
+		&cfg.TreeUploadRetryDelay,
+		&cfg.AutoExecuteOdaoProposals,
+		&cfg.OdaoSubmissionStagger,
+		&cfg.L2RateRefreshInterval,
+		&cfg.WatchtowerJsonLogs,
+		&cfg.TxConfirmationBlocks,
+		&cfg.VerifyChainID,
+		&cfg.ValidatorStatusBatchSize,
+		&cfg.ValidatorStatusTimeout,
+		&cfg.BeaconApiHeaders,
+		&cfg.BeaconApiToken,
+		&cfg.FallbackBeaconApiHeaders,
+		&cfg.FallbackBeaconApiToken,
+		&cfg.GenesisForkVersionOverride,
+		&cfg.ValidatorStateWebhookUrl,
+		&cfg.SlashingAlertInterval,
+		&cfg.SlashingAlertWebhookUrl,
+		&cfg.SlashingAlertStopValidator,
+		&cfg.CustomChainID,
+		&cfg.CustomStorageAddress,
+		&cfg.CustomOneInchOracleAddress,
+		&cfg.CustomRplTokenAddress,
+		&cfg.CustomRplFaucetAddress,
+		&cfg.CustomRethAddress,
+		&cfg.CustomOptimismMessengerAddress,
+		&cfg.CustomGnosisMessengerAddress,
+		&cfg.CustomRplTestPrice,
 	}
 }
 
@@ -466,6 +1045,9 @@ func (cfg *SmartnodeConfig) GetStakeUrl() string {
 }
 
 func (cfg *SmartnodeConfig) GetChainID() uint {
+	if cfg.isCustomNetwork() {
+		return uint(cfg.CustomChainID.Value.(uint64))
+	}
 	return cfg.chainID[cfg.Network.Value.(config.Network)]
 }
 
@@ -501,6 +1083,30 @@ func (config *SmartnodeConfig) GetWatchtowerStatePath() string {
 	return filepath.Join(DaemonDataPath, WatchtowerFolder, "state.yml")
 }
 
+func (cfg *SmartnodeConfig) GetIdempotencyCachePath() string {
+	if cfg.parent.IsNativeMode {
+		return filepath.Join(cfg.DataPath.Value.(string), IdempotencyCacheFile)
+	}
+
+	return filepath.Join(DaemonDataPath, IdempotencyCacheFile)
+}
+
+func (cfg *SmartnodeConfig) GetValidatorStatesPath() string {
+	if cfg.parent.IsNativeMode {
+		return filepath.Join(cfg.DataPath.Value.(string), ValidatorStatesFile)
+	}
+
+	return filepath.Join(DaemonDataPath, ValidatorStatesFile)
+}
+
+func (cfg *SmartnodeConfig) GetValidatorKeyExportLogPath() string {
+	if cfg.parent.IsNativeMode {
+		return filepath.Join(cfg.DataPath.Value.(string), ValidatorKeyExportLogFile)
+	}
+
+	return filepath.Join(DaemonDataPath, ValidatorKeyExportLogFile)
+}
+
 func (cfg *SmartnodeConfig) GetCustomKeyPath() string {
 	if cfg.parent.IsNativeMode {
 		return filepath.Join(cfg.DataPath.Value.(string), "custom-keys")
@@ -518,18 +1124,38 @@ func (cfg *SmartnodeConfig) GetCustomKeyPasswordFilePath() string {
 }
 
 func (cfg *SmartnodeConfig) GetStorageAddress() string {
+	if cfg.isCustomNetwork() {
+		return cfg.CustomStorageAddress.Value.(string)
+	}
 	return cfg.storageAddress[cfg.Network.Value.(config.Network)]
 }
 
 func (cfg *SmartnodeConfig) GetOneInchOracleAddress() string {
+	if cfg.isCustomNetwork() {
+		return cfg.CustomOneInchOracleAddress.Value.(string)
+	}
 	return cfg.oneInchOracleAddress[cfg.Network.Value.(config.Network)]
 }
 
+// Get the fixed RPL price to submit when the 1inch oracle isn't deployed, or 0 if there isn't one
+func (cfg *SmartnodeConfig) GetRplTestPrice() float64 {
+	if cfg.isCustomNetwork() && cfg.GetOneInchOracleAddress() == "" {
+		return cfg.CustomRplTestPrice.Value.(float64)
+	}
+	return 0
+}
+
 func (cfg *SmartnodeConfig) GetRplTokenAddress() string {
+	if cfg.isCustomNetwork() {
+		return cfg.CustomRplTokenAddress.Value.(string)
+	}
 	return cfg.rplTokenAddress[cfg.Network.Value.(config.Network)]
 }
 
 func (cfg *SmartnodeConfig) GetRplFaucetAddress() string {
+	if cfg.isCustomNetwork() {
+		return cfg.CustomRplFaucetAddress.Value.(string)
+	}
 	return cfg.rplFaucetAddress[cfg.Network.Value.(config.Network)]
 }
 
@@ -571,27 +1197,57 @@ func (cfg *SmartnodeConfig) GetConfigTitle() string {
 }
 
 func (cfg *SmartnodeConfig) GetRethAddress() common.Address {
+	if cfg.isCustomNetwork() {
+		return common.HexToAddress(cfg.CustomRethAddress.Value.(string))
+	}
 	return common.HexToAddress(cfg.rethAddress[cfg.Network.Value.(config.Network)])
 }
 
+// Get the genesis fork version override, or an empty string if there isn't one; it's only honored on the Custom network
+func (cfg *SmartnodeConfig) GetGenesisForkVersionOverride() string {
+	if !cfg.isCustomNetwork() {
+		return ""
+	}
+	override, _ := cfg.GenesisForkVersionOverride.Value.(string)
+	return strings.TrimSpace(override)
+}
+
 func getDefaultDataDir(config *RocketPoolConfig) string {
 	return filepath.Join(config.RocketPoolDirectory, "data")
 }
 
-func (cfg *SmartnodeConfig) GetRewardsTreePath(interval uint64, daemon bool) string {
+// Get the folder that rewards trees and their related files are stored in, respecting the override if one is set
+func (cfg *SmartnodeConfig) getRewardsTreesFolder(daemon bool) string {
+	overridePath := cfg.RewardsTreePath.Value.(string)
+	if overridePath == "" {
+		if daemon && !cfg.parent.IsNativeMode {
+			return filepath.Join(DaemonDataPath, RewardsTreesFolder)
+		}
+		return filepath.Join(cfg.DataPath.Value.(string), RewardsTreesFolder)
+	}
+
+	// The override folder is mounted separately into the Docker containers
 	if daemon && !cfg.parent.IsNativeMode {
-		return filepath.Join(DaemonDataPath, RewardsTreesFolder, fmt.Sprintf(RewardsTreeFilenameFormat, string(cfg.Network.Value.(config.Network)), interval))
+		return DaemonRewardsTreesPath
 	}
+	return overridePath
+}
 
-	return filepath.Join(cfg.DataPath.Value.(string), RewardsTreesFolder, fmt.Sprintf(RewardsTreeFilenameFormat, string(cfg.Network.Value.(config.Network)), interval))
+// Get the folder on the host that rewards trees are stored in, so the Docker containers can mount it
+func (cfg *SmartnodeConfig) GetRewardsTreesFolder() string {
+	return cfg.getRewardsTreesFolder(false)
+}
+
+func (cfg *SmartnodeConfig) GetRewardsTreePath(interval uint64, daemon bool) string {
+	return filepath.Join(cfg.getRewardsTreesFolder(daemon), fmt.Sprintf(RewardsTreeFilenameFormat, string(cfg.Network.Value.(config.Network)), interval))
 }
 
 func (cfg *SmartnodeConfig) GetMinipoolPerformancePath(interval uint64, daemon bool) string {
-	if daemon && !cfg.parent.IsNativeMode {
-		return filepath.Join(DaemonDataPath, RewardsTreesFolder, fmt.Sprintf(MinipoolPerformanceFilenameFormat, string(cfg.Network.Value.(config.Network)), interval))
-	}
+	return filepath.Join(cfg.getRewardsTreesFolder(daemon), fmt.Sprintf(MinipoolPerformanceFilenameFormat, string(cfg.Network.Value.(config.Network)), interval))
+}
 
-	return filepath.Join(cfg.DataPath.Value.(string), RewardsTreesFolder, fmt.Sprintf(MinipoolPerformanceFilenameFormat, string(cfg.Network.Value.(config.Network)), interval))
+func (cfg *SmartnodeConfig) GetRewardsTreeCheckpointPath(interval uint64, daemon bool) string {
+	return filepath.Join(cfg.getRewardsTreesFolder(daemon), fmt.Sprintf(RewardsCheckpointFilenameFormat, string(cfg.Network.Value.(config.Network)), interval))
 }
 
 func (cfg *SmartnodeConfig) GetRegenerateRewardsTreeRequestPath(interval uint64, daemon bool) string {
@@ -602,6 +1258,26 @@ func (cfg *SmartnodeConfig) GetRegenerateRewardsTreeRequestPath(interval uint64,
 	return filepath.Join(cfg.DataPath.Value.(string), WatchtowerFolder, fmt.Sprintf(RegenerateRewardsTreeRequestFormat, interval))
 }
 
+func (cfg *SmartnodeConfig) GetDryRunRewardsTreeRequestPath(interval uint64, daemon bool) string {
+	if daemon && !cfg.parent.IsNativeMode {
+		return filepath.Join(DaemonDataPath, WatchtowerFolder, fmt.Sprintf(DryRunRewardsTreeRequestFormat, interval))
+	}
+
+	return filepath.Join(cfg.DataPath.Value.(string), WatchtowerFolder, fmt.Sprintf(DryRunRewardsTreeRequestFormat, interval))
+}
+
+// Get the ordered list of archive EC URLs to try when the primary EC doesn't have the state for a historical block
+func (cfg *SmartnodeConfig) GetArchiveECUrls() []string {
+	urls := []string{}
+	for _, url := range strings.Split(cfg.ArchiveECUrl.Value.(string), ",") {
+		url = strings.TrimSpace(url)
+		if url != "" {
+			urls = append(urls, url)
+		}
+	}
+	return urls
+}
+
 func (cfg *SmartnodeConfig) GetWatchtowerFolder(daemon bool) string {
 	if daemon && !cfg.parent.IsNativeMode {
 		return filepath.Join(DaemonDataPath, WatchtowerFolder)
@@ -610,6 +1286,14 @@ func (cfg *SmartnodeConfig) GetWatchtowerFolder(daemon bool) string {
 	return filepath.Join(cfg.DataPath.Value.(string), WatchtowerFolder)
 }
 
+func (cfg *SmartnodeConfig) GetTransferFolder(daemon bool) string {
+	if daemon && !cfg.parent.IsNativeMode {
+		return filepath.Join(DaemonDataPath, TransferFolder)
+	}
+
+	return filepath.Join(cfg.DataPath.Value.(string), TransferFolder)
+}
+
 func (cfg *SmartnodeConfig) GetFeeRecipientFilePath() string {
 	if !cfg.parent.IsNativeMode {
 		return filepath.Join(DaemonDataPath, "validators", FeeRecipientFilename)
@@ -618,6 +1302,22 @@ func (cfg *SmartnodeConfig) GetFeeRecipientFilePath() string {
 	return filepath.Join(cfg.DataPath.Value.(string), "validators", NativeFeeRecipientFilename)
 }
 
+func (cfg *SmartnodeConfig) GetGraffitiMapPath() string {
+	if !cfg.parent.IsNativeMode {
+		return filepath.Join(DaemonDataPath, GraffitiMapFilename)
+	}
+
+	return filepath.Join(cfg.DataPath.Value.(string), GraffitiMapFilename)
+}
+
+func (cfg *SmartnodeConfig) GetValidatorGraffitiFilePath() string {
+	if !cfg.parent.IsNativeMode {
+		return filepath.Join(DaemonDataPath, "validators", ValidatorGraffitiFilename)
+	}
+
+	return filepath.Join(cfg.DataPath.Value.(string), "validators", ValidatorGraffitiFilename)
+}
+
 func (cfg *SmartnodeConfig) GetLegacyRewardsPoolAddress() common.Address {
 	return common.HexToAddress(cfg.legacyRewardsPoolAddress[cfg.Network.Value.(config.Network)])
 }
@@ -639,9 +1339,24 @@ func (cfg *SmartnodeConfig) GetPreviousRewardsPoolAddresses() map[string][]commo
 }
 
 func (cfg *SmartnodeConfig) GetOptimismMessengerAddress() string {
+	if cfg.isCustomNetwork() {
+		return cfg.CustomOptimismMessengerAddress.Value.(string)
+	}
 	return cfg.optimismPriceMessengerAddress[cfg.Network.Value.(config.Network)]
 }
 
+func (cfg *SmartnodeConfig) GetGnosisMessengerAddress() string {
+	if cfg.isCustomNetwork() {
+		return cfg.CustomGnosisMessengerAddress.Value.(string)
+	}
+	return cfg.gnosisPriceMessengerAddress[cfg.Network.Value.(config.Network)]
+}
+
 func (cfg *SmartnodeConfig) GetRewardsSubmissionBlockMaps() []uint64 {
 	return cfg.rewardsSubmissionBlockMaps[cfg.Network.Value.(config.Network)]
 }
+
+// Check if the Smartnode is set to a custom network, which takes its chain ID and contract addresses from the config
+func (cfg *SmartnodeConfig) isCustomNetwork() bool {
+	return cfg.Network.Value.(config.Network) == config.Network_Custom
+}
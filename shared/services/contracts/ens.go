@@ -0,0 +1,16 @@
+package contracts
+
+// The address of the ENS registry, which is the same on every network
+const EnsRegistryAddress string = "0x00000000000C2E074eC69A0dFb2997BA6C7d2e1e"
+
+// The subset of the ENS registry used to look up a name's resolver
+const EnsRegistryABI = `[
+  {"constant":true,"inputs":[{"name":"node","type":"bytes32"}],"name":"resolver","outputs":[{"name":"","type":"address"}],"stateMutability":"view","type":"function"}
+]`
+
+// The subset of the ENS public resolver used for reverse resolution and reading text records
+const EnsResolverABI = `[
+  {"constant":true,"inputs":[{"name":"node","type":"bytes32"}],"name":"addr","outputs":[{"name":"","type":"address"}],"stateMutability":"view","type":"function"},
+  {"constant":true,"inputs":[{"name":"node","type":"bytes32"}],"name":"name","outputs":[{"name":"","type":"string"}],"stateMutability":"view","type":"function"},
+  {"constant":true,"inputs":[{"name":"node","type":"bytes32"},{"name":"key","type":"string"}],"name":"text","outputs":[{"name":"","type":"string"}],"stateMutability":"view","type":"function"}
+]`
@@ -6,6 +6,7 @@ import (
 	"math"
 	"math/big"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/ethereum/go-ethereum"
@@ -21,14 +22,21 @@ import (
 
 // This is a proxy for multiple ETH clients, providing natural fallback support if one of them fails.
 type ExecutionClientManager struct {
-	primaryEcUrl    string
-	fallbackEcUrl   string
-	primaryEc       *ethclient.Client
-	fallbackEc      *ethclient.Client
-	logger          log.ColorLogger
-	primaryReady    bool
-	fallbackReady   bool
-	ignoreSyncCheck bool
+	primaryEcUrl     string
+	fallbackEcUrl    string
+	primaryEc        *ethclient.Client
+	fallbackEc       *ethclient.Client
+	logger           log.ColorLogger
+	primaryReady     bool
+	fallbackReady    bool
+	ignoreSyncCheck  bool
+	promotionDelay   time.Duration
+	recheckDelay     time.Duration
+	primaryDownSince time.Time
+	promoted         bool
+	lastDemotedCheck time.Time
+	demotedStatus    api.ClientStatus
+	lock             sync.RWMutex
 }
 
 // This is a signature for a wrapped ethclient.Client function
@@ -77,14 +85,22 @@ func NewExecutionClientManager(cfg *config.RocketPoolConfig) (*ExecutionClientMa
 		}
 	}
 
+	// Get the fallback promotion settings
+	promotionDelay, recheckDelay, err := getFallbackPromotionSettings(cfg)
+	if err != nil {
+		return nil, err
+	}
+
 	return &ExecutionClientManager{
-		primaryEcUrl:  primaryEcUrl,
-		fallbackEcUrl: fallbackEcUrl,
-		primaryEc:     primaryEc,
-		fallbackEc:    fallbackEc,
-		logger:        log.NewColorLogger(color.FgYellow),
-		primaryReady:  true,
-		fallbackReady: fallbackEc != nil,
+		primaryEcUrl:   primaryEcUrl,
+		fallbackEcUrl:  fallbackEcUrl,
+		primaryEc:      primaryEc,
+		fallbackEc:     fallbackEc,
+		logger:         log.NewColorLogger(color.FgYellow),
+		primaryReady:   true,
+		fallbackReady:  fallbackEc != nil,
+		promotionDelay: promotionDelay,
+		recheckDelay:   recheckDelay,
 	}, nil
 
 }
@@ -274,6 +290,17 @@ func (p *ExecutionClientManager) BlockNumber(ctx context.Context) (uint64, error
 	return result.(uint64), err
 }
 
+// ChainID returns the chain ID the client is connected to
+func (p *ExecutionClientManager) ChainID(ctx context.Context) (*big.Int, error) {
+	result, err := p.runFunction(func(client *ethclient.Client) (interface{}, error) {
+		return client.ChainID(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*big.Int), err
+}
+
 // BalanceAt returns the wei balance of the given account.
 // The block number can be nil, in which case the balance is taken from the latest known block.
 func (p *ExecutionClientManager) BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error) {
@@ -334,6 +361,9 @@ func (p *ExecutionClientManager) SyncProgress(ctx context.Context) (*ethereum.Sy
 
 func (p *ExecutionClientManager) CheckStatus() *api.ClientManagerStatus {
 
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
 	status := &api.ClientManagerStatus{
 		FallbackEnabled: p.fallbackEc != nil,
 	}
@@ -354,13 +384,16 @@ func (p *ExecutionClientManager) CheckStatus() *api.ClientManagerStatus {
 
 	// Get the fallback EC status if applicable
 	if status.FallbackEnabled {
-		status.FallbackClientStatus = checkEcStatus(p.fallbackEc)
+		status.FallbackClientStatus = p.checkFallbackStatus()
 	}
 
 	// Flag the ready clients
 	p.primaryReady = (status.PrimaryClientStatus.IsWorking && status.PrimaryClientStatus.IsSynced)
 	p.fallbackReady = (status.FallbackEnabled && status.FallbackClientStatus.IsWorking && status.FallbackClientStatus.IsSynced)
 
+	// Promote the fallback or restore the original primary if necessary
+	p.updatePromotion(status)
+
 	return status
 
 }
@@ -421,18 +454,82 @@ func checkEcStatus(client *ethclient.Client) api.ClientStatus {
 
 }
 
+// Check the fallback client status; if it's a demoted primary, only re-check it once per recheck delay.
+// The caller must hold the write lock.
+func (p *ExecutionClientManager) checkFallbackStatus() api.ClientStatus {
+	if p.promoted && time.Since(p.lastDemotedCheck) < p.recheckDelay {
+		return p.demotedStatus
+	}
+	status := checkEcStatus(p.fallbackEc)
+	if p.promoted {
+		p.lastDemotedCheck = time.Now()
+		p.demotedStatus = status
+	}
+	return status
+}
+
+// Promote the fallback client if the primary has been down for longer than the promotion delay,
+// or restore the original primary once it has recovered; the caller must hold the write lock
+func (p *ExecutionClientManager) updatePromotion(status *api.ClientManagerStatus) {
+
+	if p.promotionDelay == 0 || !status.FallbackEnabled {
+		return
+	}
+
+	// Restore the original primary once it's ready again
+	if p.promoted {
+		if p.fallbackReady {
+			p.logger.Println("NOTICE: The original primary Execution client has recovered, restoring it as the primary client.")
+			p.swapClients()
+		}
+		return
+	}
+
+	// Track how long the primary has been down
+	if p.primaryReady {
+		p.primaryDownSince = time.Time{}
+		return
+	}
+	if p.primaryDownSince.IsZero() {
+		p.primaryDownSince = time.Now()
+		return
+	}
+
+	// Promote the fallback if it's ready and the primary has been down for too long
+	downtime := time.Since(p.primaryDownSince)
+	if downtime >= p.promotionDelay && p.fallbackReady {
+		p.logger.Printlnf("NOTICE: The primary Execution client has been unavailable for %s, promoting the fallback client to primary.", downtime)
+		p.demotedStatus = status.PrimaryClientStatus
+		p.lastDemotedCheck = time.Now()
+		p.swapClients()
+	}
+
+}
+
+// Swap the primary and fallback clients; the caller must hold the write lock
+func (p *ExecutionClientManager) swapClients() {
+	p.primaryEcUrl, p.fallbackEcUrl = p.fallbackEcUrl, p.primaryEcUrl
+	p.primaryEc, p.fallbackEc = p.fallbackEc, p.primaryEc
+	p.primaryReady, p.fallbackReady = p.fallbackReady, p.primaryReady
+	p.primaryDownSince = time.Time{}
+	p.promoted = !p.promoted
+}
+
 // Attempts to run a function progressively through each client until one succeeds or they all fail.
 func (p *ExecutionClientManager) runFunction(function ecFunction) (interface{}, error) {
 
+	// Get a consistent snapshot of the clients in case they're swapped while the function runs
+	primaryEc, primaryReady, fallbackEc, fallbackReady := p.getClients()
+
 	// Check if we can use the primary
-	if p.primaryReady {
+	if primaryReady {
 		// Try to run the function on the primary
-		result, err := function(p.primaryEc)
+		result, err := function(primaryEc)
 		if err != nil {
 			if p.isDisconnected(err) {
 				// If it's disconnected, log it and try the fallback
 				p.logger.Printlnf("WARNING: Primary Execution client disconnected (%s), using fallback...", err.Error())
-				p.primaryReady = false
+				p.setNotReady(primaryEc)
 				return p.runFunction(function)
 			}
 
@@ -444,14 +541,14 @@ func (p *ExecutionClientManager) runFunction(function ecFunction) (interface{},
 		return result, nil
 	}
 
-	if p.fallbackReady {
+	if fallbackReady {
 		// Try to run the function on the fallback
-		result, err := function(p.fallbackEc)
+		result, err := function(fallbackEc)
 		if err != nil {
 			if p.isDisconnected(err) {
 				// If it's disconnected, log it and try the fallback
 				p.logger.Printlnf("WARNING: Fallback Execution client disconnected (%s)", err.Error())
-				p.fallbackReady = false
+				p.setNotReady(fallbackEc)
 				return nil, fmt.Errorf("all Execution clients failed")
 			}
 
@@ -466,7 +563,48 @@ func (p *ExecutionClientManager) runFunction(function ecFunction) (interface{},
 	return nil, fmt.Errorf("no Execution clients were ready")
 }
 
+// Get the current clients and their ready flags
+func (p *ExecutionClientManager) getClients() (*ethclient.Client, bool, *ethclient.Client, bool) {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	return p.primaryEc, p.primaryReady, p.fallbackEc, p.fallbackReady
+}
+
+// Flag a client as not ready, unless it was swapped out since it was retrieved
+func (p *ExecutionClientManager) setNotReady(client *ethclient.Client) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if client == p.primaryEc {
+		p.primaryReady = false
+	} else if client == p.fallbackEc {
+		p.fallbackReady = false
+	}
+}
+
 // Returns true if the error was a connection failure and a backup client is available
 func (p *ExecutionClientManager) isDisconnected(err error) bool {
 	return strings.Contains(err.Error(), "dial tcp")
 }
+
+// Get the delay before promoting a fallback client and the delay between re-checks of a demoted primary.
+// A promotion delay of 0 means promotion is disabled.
+func getFallbackPromotionSettings(cfg *config.RocketPoolConfig) (time.Duration, time.Duration, error) {
+
+	promotionDelayString, _ := cfg.FallbackPromotionDelay.Value.(string)
+	if promotionDelayString == "" || cfg.UseFallbackClients.Value != true {
+		return 0, 0, nil
+	}
+	promotionDelay, err := time.ParseDuration(promotionDelayString)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid fallback promotion delay [%s]: %w", promotionDelayString, err)
+	}
+
+	reconnectDelayString, _ := cfg.ReconnectDelay.Value.(string)
+	recheckDelay, err := time.ParseDuration(reconnectDelayString)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid reconnect delay [%s]: %w", reconnectDelayString, err)
+	}
+
+	return promotionDelay, recheckDelay, nil
+
+}
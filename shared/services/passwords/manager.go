@@ -25,6 +25,11 @@ func NewPasswordManager(passwordPath string) *PasswordManager {
 	}
 }
 
+// Get the path the password file is stored at
+func (pm *PasswordManager) GetPasswordPath() string {
+	return pm.passwordPath
+}
+
 // Check if the password has been set
 func (pm *PasswordManager) IsPasswordSet() bool {
 	_, err := ioutil.ReadFile(pm.passwordPath)
@@ -359,12 +359,13 @@ func checkExecutionClientStatus(ecMgr *ExecutionClientManager) (bool, rocketpool
 
 	// Check the EC status
 	mgrStatus := ecMgr.CheckStatus()
-	if ecMgr.primaryReady {
+	primaryEc, primaryReady, fallbackEc, fallbackReady := ecMgr.getClients()
+	if primaryReady {
 		return true, nil, nil
 	}
 
 	// If the primary isn't synced but there's a fallback and it is, return true
-	if ecMgr.fallbackReady {
+	if fallbackReady {
 		if mgrStatus.PrimaryClientStatus.Error != "" {
 			log.Printf("Primary execution client is unavailable (%s), using fallback execution client...\n", mgrStatus.PrimaryClientStatus.Error)
 		} else {
@@ -378,13 +379,13 @@ func checkExecutionClientStatus(ecMgr *ExecutionClientManager) (bool, rocketpool
 	// Is the primary working and syncing? If so, wait for it
 	if mgrStatus.PrimaryClientStatus.IsWorking && mgrStatus.PrimaryClientStatus.Error == "" {
 		log.Printf("Fallback execution client is not configured or unavailable, waiting for primary execution client to finish syncing (%.2f%%)\n", mgrStatus.PrimaryClientStatus.SyncProgress*100)
-		return false, ecMgr.primaryEc, nil
+		return false, primaryEc, nil
 	}
 
 	// Is the fallback working and syncing? If so, wait for it
 	if mgrStatus.FallbackEnabled && mgrStatus.FallbackClientStatus.IsWorking && mgrStatus.FallbackClientStatus.Error == "" {
 		log.Printf("Primary execution client is unavailable (%s), waiting for the fallback execution client to finish syncing (%.2f%%)\n", mgrStatus.PrimaryClientStatus.Error, mgrStatus.FallbackClientStatus.SyncProgress*100)
-		return false, ecMgr.fallbackEc, nil
+		return false, fallbackEc, nil
 	}
 
 	// If neither client is working, report the errors
@@ -399,12 +400,13 @@ func checkBeaconClientStatus(bcMgr *BeaconClientManager) (bool, error) {
 
 	// Check the BC status
 	mgrStatus := bcMgr.CheckStatus()
-	if bcMgr.primaryReady {
+	primaryReady, fallbackReady := bcMgr.getReadyFlags()
+	if primaryReady {
 		return true, nil
 	}
 
 	// If the primary isn't synced but there's a fallback and it is, return true
-	if bcMgr.fallbackReady {
+	if fallbackReady {
 		if mgrStatus.PrimaryClientStatus.Error != "" {
 			log.Printf("Primary consensus client is unavailable (%s), using fallback consensus client...\n", mgrStatus.PrimaryClientStatus.Error)
 		} else {
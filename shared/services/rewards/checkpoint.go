@@ -0,0 +1,168 @@
+package rewards
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// The intermediate state of the attestation performance check, saved periodically so an interrupted tree generation can resume
+type attestationCheckpoint struct {
+	Index               uint64                               `json:"index"`
+	ConsensusStartBlock uint64                               `json:"consensusStartBlock"`
+	ConsensusEndBlock   uint64                               `json:"consensusEndBlock"`
+	NextEpoch           uint64                               `json:"nextEpoch"`
+	Minipools           map[uint64]*minipoolCheckpoint       `json:"minipools"`
+	PendingDuties       map[uint64]map[uint64]map[int]uint64 `json:"pendingDuties"`
+}
+
+// A minipool's attestation performance so far, keyed by its validator index in the checkpoint
+type minipoolCheckpoint struct {
+	GoodAttestations        uint64   `json:"goodAttestations"`
+	MissedAttestations      uint64   `json:"missedAttestations"`
+	MissingAttestationSlots []uint64 `json:"missingAttestationSlots"`
+}
+
+// Enable checkpointing of the attestation performance check; the state is saved to the given path every interval epochs,
+// and a checkpoint for the same interval and snapshot found there is resumed from instead of starting over
+func (r *RewardsFile) SetCheckpointPath(path string, interval uint64) {
+	r.checkpointPath = path
+	r.checkpointInterval = interval
+}
+
+// Save the attestation performance state, with the next epoch that still needs to be processed
+func (r *RewardsFile) saveCheckpoint(nextEpoch uint64) error {
+	checkpoint := attestationCheckpoint{
+		Index:               r.Index,
+		ConsensusStartBlock: r.ConsensusStartBlock,
+		ConsensusEndBlock:   r.ConsensusEndBlock,
+		NextEpoch:           nextEpoch,
+		Minipools:           map[uint64]*minipoolCheckpoint{},
+		PendingDuties:       map[uint64]map[uint64]map[int]uint64{},
+	}
+
+	for validatorIndex, minipoolInfo := range r.validatorIndexMap {
+		missingSlots := make([]uint64, 0, len(minipoolInfo.MissingAttestationSlots))
+		for slot := range minipoolInfo.MissingAttestationSlots {
+			missingSlots = append(missingSlots, slot)
+		}
+		sort.Slice(missingSlots, func(i, j int) bool {
+			return missingSlots[i] < missingSlots[j]
+		})
+		checkpoint.Minipools[validatorIndex] = &minipoolCheckpoint{
+			GoodAttestations:        minipoolInfo.GoodAttestations,
+			MissedAttestations:      minipoolInfo.MissedAttestations,
+			MissingAttestationSlots: missingSlots,
+		}
+	}
+
+	for slotIndex, slotInfo := range r.intervalDutiesInfo.Slots {
+		committees := map[uint64]map[int]uint64{}
+		for committeeIndex, committeeInfo := range slotInfo.Committees {
+			positions := map[int]uint64{}
+			for position, minipoolInfo := range committeeInfo.Positions {
+				positions[position] = minipoolInfo.ValidatorIndex
+			}
+			committees[committeeIndex] = positions
+		}
+		checkpoint.PendingDuties[slotIndex] = committees
+	}
+
+	bytes, err := json.Marshal(checkpoint)
+	if err != nil {
+		return fmt.Errorf("error serializing checkpoint: %w", err)
+	}
+
+	// Write to a temporary file first so a crash mid-write doesn't corrupt the last good checkpoint
+	err = os.MkdirAll(filepath.Dir(r.checkpointPath), 0755)
+	if err != nil {
+		return fmt.Errorf("error creating checkpoint folder: %w", err)
+	}
+	tempPath := r.checkpointPath + ".tmp"
+	err = ioutil.WriteFile(tempPath, bytes, 0644)
+	if err != nil {
+		return fmt.Errorf("error saving checkpoint: %w", err)
+	}
+	return os.Rename(tempPath, r.checkpointPath)
+}
+
+// Restore the attestation performance state from a saved checkpoint, returning the next epoch to process
+// If there is no checkpoint, or it belongs to a different interval, snapshot, or set of minipools, it returns false and nothing is changed
+func (r *RewardsFile) loadCheckpoint() (uint64, bool, error) {
+	bytes, err := ioutil.ReadFile(r.checkpointPath)
+	if os.IsNotExist(err) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, fmt.Errorf("error reading checkpoint: %w", err)
+	}
+
+	var checkpoint attestationCheckpoint
+	err = json.Unmarshal(bytes, &checkpoint)
+	if err != nil {
+		r.log.Printlnf("%s WARNING: Ignoring checkpoint %s because it couldn't be read: %s", r.logPrefix, r.checkpointPath, err.Error())
+		return 0, false, nil
+	}
+	if checkpoint.Index != r.Index || checkpoint.ConsensusStartBlock != r.ConsensusStartBlock || checkpoint.ConsensusEndBlock != r.ConsensusEndBlock {
+		r.log.Printlnf("%s Ignoring checkpoint %s because it was made for a different interval or snapshot", r.logPrefix, r.checkpointPath)
+		return 0, false, nil
+	}
+	if len(checkpoint.Minipools) != len(r.validatorIndexMap) {
+		r.log.Printlnf("%s Ignoring checkpoint %s because it was made for a different set of minipools", r.logPrefix, r.checkpointPath)
+		return 0, false, nil
+	}
+	for validatorIndex := range checkpoint.Minipools {
+		if _, exists := r.validatorIndexMap[validatorIndex]; !exists {
+			r.log.Printlnf("%s Ignoring checkpoint %s because it was made for a different set of minipools", r.logPrefix, r.checkpointPath)
+			return 0, false, nil
+		}
+	}
+
+	// Restore the minipool performance
+	for validatorIndex, minipoolState := range checkpoint.Minipools {
+		minipoolInfo := r.validatorIndexMap[validatorIndex]
+		minipoolInfo.GoodAttestations = minipoolState.GoodAttestations
+		minipoolInfo.MissedAttestations = minipoolState.MissedAttestations
+		minipoolInfo.MissingAttestationSlots = map[uint64]bool{}
+		for _, slot := range minipoolState.MissingAttestationSlots {
+			minipoolInfo.MissingAttestationSlots[slot] = true
+		}
+	}
+
+	// Restore the duties that were still waiting for an attestation
+	r.intervalDutiesInfo.Slots = map[uint64]*SlotInfo{}
+	for slotIndex, committees := range checkpoint.PendingDuties {
+		slotInfo := &SlotInfo{
+			Index:      slotIndex,
+			Committees: map[uint64]*CommitteeInfo{},
+		}
+		for committeeIndex, positions := range committees {
+			committeeInfo := &CommitteeInfo{
+				Index:     committeeIndex,
+				Positions: map[int]*MinipoolInfo{},
+			}
+			for position, validatorIndex := range positions {
+				minipoolInfo, exists := r.validatorIndexMap[validatorIndex]
+				if !exists {
+					return 0, false, fmt.Errorf("checkpoint has a pending duty for unknown validator %d", validatorIndex)
+				}
+				committeeInfo.Positions[position] = minipoolInfo
+			}
+			slotInfo.Committees[committeeIndex] = committeeInfo
+		}
+		r.intervalDutiesInfo.Slots[slotIndex] = slotInfo
+	}
+
+	return checkpoint.NextEpoch, true, nil
+}
+
+// Remove the checkpoint once the attestation performance check is complete
+func (r *RewardsFile) deleteCheckpoint() {
+	err := os.Remove(r.checkpointPath)
+	if err != nil && !os.IsNotExist(err) {
+		r.log.Printlnf("%s WARNING: Couldn't remove checkpoint %s: %s", r.logPrefix, r.checkpointPath, err.Error())
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -29,9 +30,9 @@ const (
 )
 
 // Gets the intervals the node can claim and the intervals that have already been claimed
-func GetClaimStatus(rp *rocketpool.RocketPool, nodeAddress common.Address) (unclaimed []uint64, claimed []uint64, err error) {
+func GetClaimStatus(rp *rocketpool.RocketPool, nodeAddress common.Address, opts *bind.CallOpts) (unclaimed []uint64, claimed []uint64, err error) {
 	// Get the current interval
-	currentIndexBig, err := rewards.GetRewardIndex(rp, nil)
+	currentIndexBig, err := rewards.GetRewardIndex(rp, opts)
 	if err != nil {
 		return
 	}
@@ -51,7 +52,7 @@ func GetClaimStatus(rp *rocketpool.RocketPool, nodeAddress common.Address) (uncl
 		bucketBig.FillBytes(bucketBytes[:])
 
 		var bitmap *big.Int
-		bitmap, err = rp.RocketStorage.GetUint(nil, crypto.Keccak256Hash([]byte("rewards.interval.claimed"), nodeAddress.Bytes(), bucketBytes[:]))
+		bitmap, err = rp.RocketStorage.GetUint(opts, crypto.Keccak256Hash([]byte("rewards.interval.claimed"), nodeAddress.Bytes(), bucketBytes[:]))
 
 		for j := uint64(0); j < 256; j++ {
 			targetIndex := i*256 + j
@@ -3,6 +3,7 @@ package rewards
 import (
 	"context"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"math/big"
 	"sort"
@@ -22,6 +23,7 @@ import (
 	"github.com/rocket-pool/smartnode/shared/services/beacon"
 	"github.com/rocket-pool/smartnode/shared/services/config"
 	"github.com/rocket-pool/smartnode/shared/utils/log"
+	rputils "github.com/rocket-pool/smartnode/shared/utils/rp"
 	"github.com/wealdtech/go-merkletree"
 	"github.com/wealdtech/go-merkletree/keccak256"
 	"golang.org/x/sync/errgroup"
@@ -121,6 +123,9 @@ type RewardsFile struct {
 	epsilon              *big.Int                  `json:"-"`
 	intervalSeconds      *big.Int                  `json:"-"`
 	beaconConfig         beacon.Eth2Config         `json:"-"`
+	progressCallback     ProgressCallback          `json:"-"`
+	checkpointPath       string                    `json:"-"`
+	checkpointInterval   uint64                    `json:"-"`
 }
 
 // Create a new rewards file
@@ -154,6 +159,31 @@ func NewRewardsFile(log log.ColorLogger, logPrefix string, index uint64, startTi
 	}
 }
 
+// Deserialize a rewards file, making sure it was generated with the same rewards file version this Smartnode generates
+func DeserializeRewardsFile(bytes []byte) (*RewardsFile, error) {
+	rewardsFile := new(RewardsFile)
+	err := json.Unmarshal(bytes, rewardsFile)
+	if err != nil {
+		return nil, fmt.Errorf("error deserializing rewards file: %w", err)
+	}
+	if rewardsFile.RewardsFileVersion != RewardsFileVersion {
+		return nil, fmt.Errorf("rewards file has version %d but this Smartnode generates version %d", rewardsFile.RewardsFileVersion, RewardsFileVersion)
+	}
+	return rewardsFile, nil
+}
+
+// Set a callback that will be invoked with the progress of the slower stages of tree generation
+func (r *RewardsFile) SetProgressCallback(callback ProgressCallback) {
+	r.progressCallback = callback
+}
+
+// Report the progress of a generation stage to the callback, if one was provided
+func (r *RewardsFile) reportProgress(stage string, progress float64) {
+	if r.progressCallback != nil {
+		r.progressCallback(stage, progress)
+	}
+}
+
 func (r *RewardsFile) GenerateTree(rp *rocketpool.RocketPool, cfg *config.RocketPoolConfig, bc beacon.Client) error {
 
 	// Provision some struct params
@@ -259,6 +289,55 @@ func (r *RewardsFile) ApproximateStakerShareOfSmoothingPool(rp *rocketpool.Rocke
 	return &r.TotalRewards.PoolStakerSmoothingPoolEth.Int, nil
 }
 
+// Calculates provisional rewards for every node without processing Beacon performance or building the Merkle tree
+// Every eligible minipool is treated as having perfect attestation performance for the Smoothing Pool share
+// Used for projecting the rewards of the interval that is still in progress
+func (r *RewardsFile) CalculateProvisionalRewards(rp *rocketpool.RocketPool, cfg *config.RocketPoolConfig, bc beacon.Client) error {
+	r.rp = rp
+	r.cfg = cfg
+	r.bc = bc
+	r.validNetworkCache = map[uint64]bool{
+		0: true,
+	}
+
+	// Set the network name
+	r.Network = fmt.Sprint(cfg.Smartnode.Network.Value)
+	r.MinipoolPerformanceFile.Network = r.Network
+
+	// Get the addresses for all nodes
+	r.opts = &bind.CallOpts{
+		BlockNumber: r.elSnapshotHeader.Number,
+	}
+	nodeAddresses, err := node.GetNodeAddresses(rp, r.opts)
+	if err != nil {
+		return fmt.Errorf("Error getting node addresses: %w", err)
+	}
+	r.nodeAddresses = nodeAddresses
+
+	// Get the minipool count - this will be used for an error epsilon due to division truncation
+	minipoolCount, err := minipool.GetMinipoolCount(rp, r.opts)
+	if err != nil {
+		return fmt.Errorf("Error getting minipool count: %w", err)
+	}
+	r.epsilon = big.NewInt(int64(minipoolCount))
+
+	// Calculate the RPL rewards
+	err = r.calculateRplRewards()
+	if err != nil {
+		return fmt.Errorf("Error calculating RPL rewards: %w", err)
+	}
+
+	// Calculate the ETH rewards
+	err = r.calculateEthRewards(false)
+	if err != nil {
+		return fmt.Errorf("Error calculating ETH rewards: %w", err)
+	}
+
+	// Calculate the network reward map and the totals
+	r.updateNetworksAndTotals()
+	return nil
+}
+
 // Generates a merkle tree from the provided rewards map
 func (r *RewardsFile) generateMerkleTree() error {
 
@@ -853,12 +932,29 @@ func (r *RewardsFile) processAttestationsForInterval() error {
 	r.log.Printlnf("%s Checking participation of %d minipools for epochs %d to %d", r.logPrefix, len(r.validatorIndexMap), startEpoch, endEpoch)
 	r.log.Printlnf("%s NOTE: this will take a long time, progress is reported every 100 epochs", r.logPrefix)
 
+	// Resume from a checkpoint if there is one for this interval
+	firstEpoch := startEpoch
+	useCheckpoints := (r.checkpointPath != "" && r.checkpointInterval > 0)
+	if useCheckpoints {
+		nextEpoch, resumed, err := r.loadCheckpoint()
+		if err != nil {
+			return err
+		}
+		if resumed {
+			r.log.Printlnf("%s Resuming from the checkpoint at epoch %d", r.logPrefix, nextEpoch)
+			firstEpoch = nextEpoch
+		}
+	}
+
 	epochsDone := 0
+	epochsSinceCheckpoint := uint64(0)
 	reportStartTime := time.Now()
-	for epoch := startEpoch; epoch < endEpoch+1; epoch++ {
+	for epoch := firstEpoch; epoch < endEpoch+1; epoch++ {
 		if epochsDone == 100 {
 			timeTaken := time.Since(reportStartTime)
-			r.log.Printlnf("%s On Epoch %d of %d (%.2f%%)... (%s so far)", r.logPrefix, epoch, endEpoch, float64(epoch-startEpoch)/float64(endEpoch-startEpoch)*100.0, timeTaken)
+			progress := float64(epoch-startEpoch) / float64(endEpoch-startEpoch)
+			r.log.Printlnf("%s On Epoch %d of %d (%.2f%%)... (%s so far)", r.logPrefix, epoch, endEpoch, progress*100.0, timeTaken)
+			r.reportProgress(AttestationProgressStage, progress)
 			epochsDone = 0
 		}
 
@@ -868,6 +964,14 @@ func (r *RewardsFile) processAttestationsForInterval() error {
 		}
 
 		epochsDone++
+		epochsSinceCheckpoint++
+		if useCheckpoints && epochsSinceCheckpoint == r.checkpointInterval {
+			err = r.saveCheckpoint(epoch + 1)
+			if err != nil {
+				r.log.Printlnf("%s WARNING: Couldn't save checkpoint: %s", r.logPrefix, err.Error())
+			}
+			epochsSinceCheckpoint = 0
+		}
 	}
 
 	// Check the epoch after the end of the interval for any lingering attestations
@@ -877,7 +981,12 @@ func (r *RewardsFile) processAttestationsForInterval() error {
 		return err
 	}
 
+	if useCheckpoints {
+		r.deleteCheckpoint()
+	}
+
 	r.log.Printlnf("%s Finished participation check (total time = %s)", r.logPrefix, time.Since(reportStartTime))
+	r.reportProgress(AttestationProgressStage, 1)
 	return nil
 
 }
@@ -1129,7 +1238,7 @@ func (r *RewardsFile) getSmoothingPoolNodeDetails() error {
 							if err != nil {
 								return fmt.Errorf("Error getting penalty count for minipool %s on node %s: %w", mpd.Address.Hex(), nodeDetails.Address.Hex(), err)
 							}
-							if penaltyCount >= 3 {
+							if penaltyCount >= rputils.MinipoolPenaltyThreshold {
 								// This node is a cheater
 								nodeDetails.IsEligible = false
 								nodeDetails.EligibleSeconds = big.NewInt(0)
@@ -1166,6 +1275,7 @@ func (r *RewardsFile) getSmoothingPoolNodeDetails() error {
 		if err := wg.Wait(); err != nil {
 			return err
 		}
+		r.reportProgress(NodeDetailsProgressStage, float64(iterationEndIndex)/float64(nodeCount))
 	}
 
 	return nil
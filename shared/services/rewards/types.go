@@ -10,6 +10,15 @@ import (
 	"github.com/rocket-pool/rocketpool-go/types"
 )
 
+// Names of the tree generation stages that report progress
+const (
+	NodeDetailsProgressStage string = "Loading Smoothing Pool node details"
+	AttestationProgressStage string = "Checking minipool attestations"
+)
+
+// Callback for reporting the fraction (0 to 1) of a tree generation stage that has been completed
+type ProgressCallback func(stage string, progress float64)
+
 // Information about an interval
 type IntervalInfo struct {
 	Index                  uint64        `json:"index"`
@@ -28,6 +28,7 @@ import (
 	"github.com/mitchellh/go-homedir"
 	"github.com/rocket-pool/smartnode/addons/graffiti_wall_writer"
 	"github.com/rocket-pool/smartnode/shared/services/config"
+	"github.com/rocket-pool/smartnode/shared/types/api"
 	cfgtypes "github.com/rocket-pool/smartnode/shared/types/config"
 	"github.com/rocket-pool/smartnode/shared/utils/rp"
 )
@@ -88,6 +89,8 @@ type Client struct {
 	maxPrioFee         float64
 	gasLimit           uint64
 	customNonce        *big.Int
+	idempotencyKey     string
+	useFinalizedState  bool
 	client             *ssh.Client
 	originalMaxFee     float64
 	originalMaxPrioFee float64
@@ -99,13 +102,19 @@ type Client struct {
 
 // Create new Rocket Pool client from CLI context
 func NewClientFromCtx(c *cli.Context) (*Client, error) {
-	return NewClient(c.GlobalString("config-path"),
+	client, err := NewClient(c.GlobalString("config-path"),
 		c.GlobalString("daemon-path"),
 		c.GlobalFloat64("maxFee"),
 		c.GlobalFloat64("maxPrioFee"),
 		c.GlobalUint64("gasLimit"),
 		c.GlobalString("nonce"),
 		c.GlobalBool("debug"))
+	if err != nil {
+		return nil, err
+	}
+	client.SetIdempotencyKey(c.GlobalString("idempotency-key"))
+	client.SetUseFinalizedState(c.GlobalBool("finalized"))
+	return client, nil
 }
 
 // Create new Rocket Pool client
@@ -946,6 +955,17 @@ func (c *Client) AssignGasSettings(maxFee float64, maxPrioFee float64, gasLimit
 	c.gasLimit = gasLimit
 }
 
+// Set the idempotency key for the transaction-building API calls made by this client, so retries of them can't build a second transaction.
+// The key is kept for the client's lifetime since the daemon only honors it on transaction-building routes.
+func (c *Client) SetIdempotencyKey(key string) {
+	c.idempotencyKey = key
+}
+
+// Set whether read-only API calls should be run against the latest finalized block instead of the chain head
+func (c *Client) SetUseFinalizedState(useFinalizedState bool) {
+	c.useFinalizedState = useFinalizedState
+}
+
 // Set the flags for ignoring EC and CC sync checks and forcing fallbacks to prevent unnecessary duplication of effort by the API during CLI commands
 func (c *Client) SetClientStatusFlags(ignoreSyncCheck bool, forceFallbacks bool) {
 	c.ignoreSyncCheck = ignoreSyncCheck
@@ -1187,6 +1207,12 @@ func (c *Client) compose(composeFiles []string, args string) (string, error) {
 		externalIP = ip.String()
 	}
 
+	// Generate the per-validator graffiti file before the environment variables that point the validator client at it
+	err = cfg.DeployValidatorGraffitiFile()
+	if err != nil {
+		fmt.Printf("Warning: couldn't generate the validator graffiti file: %s\n", err.Error())
+	}
+
 	// Set up environment variables and deploy the template config files
 	settings := cfg.GenerateEnvironmentVariables()
 	settings["EXTERNAL_IP"] = shellescape.Quote(externalIP)
@@ -1454,6 +1480,43 @@ func (c *Client) composeAddons(cfg *config.RocketPoolConfig, rocketpoolDir strin
 
 }
 
+// Write a file into the data folder's transfer directory so the daemon can read it without its contents going through the command line.
+// Returns the path the daemon should read it from, and a function that deletes it once the call is done.
+func (c *Client) writeTransferFile(namePattern string, contents []byte) (string, func(), error) {
+	cfg, _, err := c.LoadConfig()
+	if err != nil {
+		return "", nil, fmt.Errorf("error loading config: %w", err)
+	}
+	transferFolder, err := homedir.Expand(cfg.Smartnode.GetTransferFolder(false))
+	if err != nil {
+		return "", nil, fmt.Errorf("error expanding transfer folder path: %w", err)
+	}
+	err = os.MkdirAll(transferFolder, 0700)
+	if err != nil {
+		return "", nil, fmt.Errorf("error creating transfer folder [%s]: %w", transferFolder, err)
+	}
+
+	// Temp files are only readable by their owner
+	file, err := ioutil.TempFile(transferFolder, namePattern)
+	if err != nil {
+		return "", nil, fmt.Errorf("error creating transfer file in [%s]: %w", transferFolder, err)
+	}
+	cleanup := func() {
+		os.Remove(file.Name())
+	}
+	_, err = file.Write(contents)
+	closeErr := file.Close()
+	if err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		cleanup()
+		return "", nil, fmt.Errorf("error writing transfer file [%s]: %w", file.Name(), err)
+	}
+
+	return filepath.Join(cfg.Smartnode.GetTransferFolder(true), filepath.Base(file.Name())), cleanup, nil
+}
+
 // Call the Rocket Pool API
 func (c *Client) callAPI(args string, otherArgs ...string) ([]byte, error) {
 	// Sanitize and parse the args
@@ -1466,15 +1529,17 @@ func (c *Client) callAPI(args string, otherArgs ...string) ([]byte, error) {
 		if err != nil {
 			return []byte{}, err
 		}
-		cmd = fmt.Sprintf("docker exec %s %s %s %s %s %s api %s", shellescape.Quote(containerName), shellescape.Quote(APIBinPath), ignoreSyncCheckFlag, forceFallbackECFlag, c.getGasOpts(), c.getCustomNonce(), args)
+		cmd = fmt.Sprintf("docker exec %s %s %s %s %s %s %s %s api %s", shellescape.Quote(containerName), shellescape.Quote(APIBinPath), ignoreSyncCheckFlag, forceFallbackECFlag, c.getGasOpts(), c.getCustomNonce(), c.getIdempotencyKey(), c.getFinalizedFlag(args), args)
 	} else {
-		cmd = fmt.Sprintf("%s --settings %s %s %s %s %s api %s",
+		cmd = fmt.Sprintf("%s --settings %s %s %s %s %s %s %s api %s",
 			c.daemonPath,
 			shellescape.Quote(fmt.Sprintf("%s/%s", c.configPath, SettingsFile)),
 			ignoreSyncCheckFlag,
 			forceFallbackECFlag,
 			c.getGasOpts(),
 			c.getCustomNonce(),
+			c.getIdempotencyKey(),
+			c.getFinalizedFlag(args),
 			args)
 	}
 
@@ -1499,13 +1564,13 @@ func (c *Client) callAPIWithEnvVars(envVars map[string]string, args string, othe
 		if err != nil {
 			return []byte{}, err
 		}
-		cmd = fmt.Sprintf("docker exec %s %s %s %s %s %s %s api %s", envArgs, shellescape.Quote(containerName), shellescape.Quote(APIBinPath), ignoreSyncCheckFlag, forceFallbackECFlag, c.getGasOpts(), c.getCustomNonce(), args)
+		cmd = fmt.Sprintf("docker exec %s %s %s %s %s %s %s %s %s api %s", envArgs, shellescape.Quote(containerName), shellescape.Quote(APIBinPath), ignoreSyncCheckFlag, forceFallbackECFlag, c.getGasOpts(), c.getCustomNonce(), c.getIdempotencyKey(), c.getFinalizedFlag(args), args)
 	} else {
 		envArgs := ""
 		for key, value := range envVars {
 			envArgs += fmt.Sprintf("%s=%s ", key, shellescape.Quote(value))
 		}
-		cmd = fmt.Sprintf("%s %s --settings %s %s %s %s %s api %s",
+		cmd = fmt.Sprintf("%s %s --settings %s %s %s %s %s %s %s api %s",
 			envArgs,
 			c.daemonPath,
 			shellescape.Quote(fmt.Sprintf("%s/%s", c.configPath, SettingsFile)),
@@ -1513,6 +1578,8 @@ func (c *Client) callAPIWithEnvVars(envVars map[string]string, args string, othe
 			forceFallbackECFlag,
 			c.getGasOpts(),
 			c.getCustomNonce(),
+			c.getIdempotencyKey(),
+			c.getFinalizedFlag(args),
 			args)
 	}
 
@@ -1604,6 +1671,25 @@ func (c *Client) getCustomNonce() string {
 	return nonce
 }
 
+func (c *Client) getIdempotencyKey() string {
+	// Set the idempotency key
+	key := ""
+	if c.idempotencyKey != "" {
+		key = fmt.Sprintf("--idempotency-key %s", shellescape.Quote(c.idempotencyKey))
+	}
+	return key
+}
+
+func (c *Client) getFinalizedFlag(args string) string {
+	// Pin read-only queries to the finalized block on the routes that support it
+	flag := ""
+	fields := strings.Fields(args)
+	if c.useFinalizedState && len(fields) >= 2 && api.FinalizedStateRoutes[fields[0]+" "+fields[1]] {
+		flag = "--finalized"
+	}
+	return flag
+}
+
 // Get the first downloader available to the system
 func (c *Client) getDownloader() (string, error) {
 
@@ -4,8 +4,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/big"
+	"strings"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/rocketpool-go/types"
 
 	"github.com/rocket-pool/smartnode/shared/types/api"
 )
@@ -232,6 +234,98 @@ func (c *Client) CanFinaliseMinipool(address common.Address) (api.CanFinaliseMin
 	return response, nil
 }
 
+// Export a minipool's validator deposit data in the staking-deposit-cli layout
+// The amount is the minipool's own deposit rather than 32 ETH, so the result isn't a deposit file for the Launchpad
+func (c *Client) ExportMinipoolDepositData(address common.Address) (api.ExportMinipoolDepositDataResponse, error) {
+	responseBytes, err := c.callAPI(fmt.Sprintf("minipool export-deposit-data %s", address.Hex()))
+	if err != nil {
+		return api.ExportMinipoolDepositDataResponse{}, fmt.Errorf("Could not export minipool deposit data: %w", err)
+	}
+	var response api.ExportMinipoolDepositDataResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.ExportMinipoolDepositDataResponse{}, fmt.Errorf("Could not decode export minipool deposit data response: %w", err)
+	}
+	if response.Error != "" {
+		return api.ExportMinipoolDepositDataResponse{}, fmt.Errorf("Could not export minipool deposit data: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Get the Beacon Chain withdrawals received by each of the node's minipools over a range of up to 1024 slots
+func (c *Client) GetMinipoolBeaconWithdrawals(startSlot uint64, endSlot uint64) (api.GetMinipoolBeaconWithdrawalsResponse, error) {
+	responseBytes, err := c.callAPI(fmt.Sprintf("minipool get-beacon-withdrawals %d %d", startSlot, endSlot))
+	if err != nil {
+		return api.GetMinipoolBeaconWithdrawalsResponse{}, fmt.Errorf("Could not get minipool Beacon withdrawals: %w", err)
+	}
+	var response api.GetMinipoolBeaconWithdrawalsResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.GetMinipoolBeaconWithdrawalsResponse{}, fmt.Errorf("Could not decode minipool Beacon withdrawals response: %w", err)
+	}
+	if response.Error != "" {
+		return api.GetMinipoolBeaconWithdrawalsResponse{}, fmt.Errorf("Could not get minipool Beacon withdrawals: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Preview how the node's staking minipools' balances would be split between the node and rETH
+func (c *Client) GetMinipoolBalanceSplits() (api.GetMinipoolBalanceSplitsResponse, error) {
+	responseBytes, err := c.callAPI("minipool get-balance-splits")
+	if err != nil {
+		return api.GetMinipoolBalanceSplitsResponse{}, fmt.Errorf("Could not get minipool balance splits: %w", err)
+	}
+	var response api.GetMinipoolBalanceSplitsResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.GetMinipoolBalanceSplitsResponse{}, fmt.Errorf("Could not decode minipool balance splits response: %w", err)
+	}
+	if response.Error != "" {
+		return api.GetMinipoolBalanceSplitsResponse{}, fmt.Errorf("Could not get minipool balance splits: %s", response.Error)
+	}
+	for i := 0; i < len(response.Splits); i++ {
+		split := &response.Splits[i]
+		if split.NodeDepositBalance == nil {
+			split.NodeDepositBalance = big.NewInt(0)
+		}
+		if split.Balance == nil {
+			split.Balance = big.NewInt(0)
+		}
+		if split.EffectiveBalance == nil {
+			split.EffectiveBalance = big.NewInt(0)
+		}
+		if split.NodeShare == nil {
+			split.NodeShare = big.NewInt(0)
+		}
+		if split.RethShare == nil {
+			split.RethShare = big.NewInt(0)
+		}
+	}
+	return response, nil
+}
+
+// Get the finalization readiness of all of the node's minipools
+func (c *Client) GetMinipoolFinaliseDetails() (api.GetMinipoolFinaliseDetailsResponse, error) {
+	responseBytes, err := c.callAPI("minipool get-finalize-details")
+	if err != nil {
+		return api.GetMinipoolFinaliseDetailsResponse{}, fmt.Errorf("Could not get minipool finalize details: %w", err)
+	}
+	var response api.GetMinipoolFinaliseDetailsResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.GetMinipoolFinaliseDetailsResponse{}, fmt.Errorf("Could not decode minipool finalize details response: %w", err)
+	}
+	if response.Error != "" {
+		return api.GetMinipoolFinaliseDetailsResponse{}, fmt.Errorf("Could not get minipool finalize details: %s", response.Error)
+	}
+	for i := 0; i < len(response.Details); i++ {
+		details := &response.Details[i]
+		if details.Balance == nil {
+			details.Balance = big.NewInt(0)
+		}
+		if details.NodeShare == nil {
+			details.NodeShare = big.NewInt(0)
+		}
+	}
+	return response, nil
+}
+
 // Finalise a minipool
 func (c *Client) FinaliseMinipool(address common.Address) (api.FinaliseMinipoolResponse, error) {
 	responseBytes, err := c.callAPI(fmt.Sprintf("minipool finalise %s", address.Hex()))
@@ -344,6 +438,39 @@ func (c *Client) SetUseLatestDelegateMinipool(address common.Address, setting bo
 	return response, nil
 }
 
+// Check whether the 'always use latest delegate' toggle can be set on all of the node's minipools
+func (c *Client) CanSetUseLatestDelegateBulk(setting bool) (api.CanSetUseLatestDelegateBulkResponse, error) {
+	responseBytes, err := c.callAPI(fmt.Sprintf("minipool can-set-use-latest-delegate-bulk %t", setting))
+	if err != nil {
+		return api.CanSetUseLatestDelegateBulkResponse{}, fmt.Errorf("Could not get can set use latest delegate for minipools status: %w", err)
+	}
+	var response api.CanSetUseLatestDelegateBulkResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.CanSetUseLatestDelegateBulkResponse{}, fmt.Errorf("Could not decode can set use latest delegate for minipools response: %w", err)
+	}
+	if response.Error != "" {
+		return api.CanSetUseLatestDelegateBulkResponse{}, fmt.Errorf("Could not get can set use latest delegate for minipools status: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Set the 'always use latest delegate' toggle on all of the node's minipools.
+// Minipools that couldn't be updated have their Error set; the TXs sent for the others are still returned.
+func (c *Client) SetUseLatestDelegateBulk(setting bool) (api.SetUseLatestDelegateBulkResponse, error) {
+	responseBytes, err := c.callAPI(fmt.Sprintf("minipool set-use-latest-delegate-bulk %t", setting))
+	if err != nil {
+		return api.SetUseLatestDelegateBulkResponse{}, fmt.Errorf("Could not set use latest delegate for minipools: %w", err)
+	}
+	var response api.SetUseLatestDelegateBulkResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.SetUseLatestDelegateBulkResponse{}, fmt.Errorf("Could not decode set use latest delegate for minipools response: %w", err)
+	}
+	if response.Error != "" {
+		return api.SetUseLatestDelegateBulkResponse{}, fmt.Errorf("Could not set use latest delegate for minipools: %s", response.Error)
+	}
+	return response, nil
+}
+
 // Get the artifacts necessary for vanity address searching
 func (c *Client) GetVanityArtifacts(depositAmount *big.Int, nodeAddress string) (api.GetVanityArtifactsResponse, error) {
 	responseBytes, err := c.callAPI(fmt.Sprintf("minipool get-vanity-artifacts %s %s", depositAmount.String(), nodeAddress))
@@ -359,3 +486,77 @@ func (c *Client) GetVanityArtifacts(depositAmount *big.Int, nodeAddress string)
 	}
 	return response, nil
 }
+
+// Import a minipool's validator key from an encrypted EIP-2335 keystore
+func (c *Client) ImportMinipoolKey(address common.Address, keystore []byte, password string) (api.ImportMinipoolKeyResponse, error) {
+	keystorePath, cleanupKeystore, err := c.writeTransferFile("keystore-*.json", keystore)
+	if err != nil {
+		return api.ImportMinipoolKeyResponse{}, fmt.Errorf("Could not import minipool validator key: %w", err)
+	}
+	defer cleanupKeystore()
+	passwordPath, cleanupPassword, err := c.writeTransferFile("keystore-password-*", []byte(password))
+	if err != nil {
+		return api.ImportMinipoolKeyResponse{}, fmt.Errorf("Could not import minipool validator key: %w", err)
+	}
+	defer cleanupPassword()
+	responseBytes, err := c.callAPI(fmt.Sprintf("minipool import-key %s", address.Hex()), keystorePath, passwordPath)
+	if err != nil {
+		return api.ImportMinipoolKeyResponse{}, fmt.Errorf("Could not import minipool validator key: %w", err)
+	}
+	var response api.ImportMinipoolKeyResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.ImportMinipoolKeyResponse{}, fmt.Errorf("Could not decode import minipool validator key response: %w", err)
+	}
+	if response.Error != "" {
+		return api.ImportMinipoolKeyResponse{}, fmt.Errorf("Could not import minipool validator key: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Get presigned voluntary exit messages for the given validators at the current epoch
+// If no pubkeys are provided, messages are generated for all of the node's validating minipools
+// The messages are signed for the current fork, so they have to be regenerated after every hard fork
+func (c *Client) GetMinipoolExitMessages(pubkeys []types.ValidatorPubkey) (api.GetMinipoolExitMessagesResponse, error) {
+	otherArgs := []string{}
+	if len(pubkeys) > 0 {
+		pubkeyStrings := make([]string, len(pubkeys))
+		for i, pubkey := range pubkeys {
+			pubkeyStrings[i] = pubkey.Hex()
+		}
+		otherArgs = append(otherArgs, strings.Join(pubkeyStrings, ","))
+	}
+	responseBytes, err := c.callAPI("minipool get-exit-messages", otherArgs...)
+	if err != nil {
+		return api.GetMinipoolExitMessagesResponse{}, fmt.Errorf("Could not get minipool exit messages: %w", err)
+	}
+	var response api.GetMinipoolExitMessagesResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.GetMinipoolExitMessagesResponse{}, fmt.Errorf("Could not decode minipool exit messages response: %w", err)
+	}
+	if response.Error != "" {
+		return api.GetMinipoolExitMessagesResponse{}, fmt.Errorf("Could not get minipool exit messages: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Get a minipool's position in the deposit assignment queue
+func (c *Client) GetMinipoolQueuePosition(address common.Address) (api.GetMinipoolQueuePositionResponse, error) {
+	responseBytes, err := c.callAPI(fmt.Sprintf("minipool get-queue-position %s", address.Hex()))
+	if err != nil {
+		return api.GetMinipoolQueuePositionResponse{}, fmt.Errorf("Could not get minipool queue position: %w", err)
+	}
+	var response api.GetMinipoolQueuePositionResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.GetMinipoolQueuePositionResponse{}, fmt.Errorf("Could not decode minipool queue position response: %w", err)
+	}
+	if response.Error != "" {
+		return api.GetMinipoolQueuePositionResponse{}, fmt.Errorf("Could not get minipool queue position: %s", response.Error)
+	}
+	if response.QueueCapacity == nil {
+		response.QueueCapacity = big.NewInt(0)
+	}
+	if response.DepositPoolBalance == nil {
+		response.DepositPoolBalance = big.NewInt(0)
+	}
+	return response, nil
+}
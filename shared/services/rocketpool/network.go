@@ -49,6 +49,63 @@ func (c *Client) RplPrice() (api.RplPriceResponse, error) {
 	return response, nil
 }
 
+// Get the network's total effective RPL stake and the RPL price at a past block
+func (c *Client) EffectiveRplAtBlock(blockNumber uint64) (api.EffectiveRplAtBlockResponse, error) {
+	responseBytes, err := c.callAPI(fmt.Sprintf("network effective-rpl-at-block %d", blockNumber))
+	if err != nil {
+		return api.EffectiveRplAtBlockResponse{}, fmt.Errorf("Could not get effective RPL stake at block %d: %w", blockNumber, err)
+	}
+	var response api.EffectiveRplAtBlockResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.EffectiveRplAtBlockResponse{}, fmt.Errorf("Could not decode effective RPL stake response: %w", err)
+	}
+	if response.Error != "" {
+		return api.EffectiveRplAtBlockResponse{}, fmt.Errorf("Could not get effective RPL stake at block %d: %s", blockNumber, response.Error)
+	}
+	if response.EffectiveRplStake == nil {
+		response.EffectiveRplStake = big.NewInt(0)
+	}
+	if response.RplPrice == nil {
+		response.RplPrice = big.NewInt(0)
+	}
+	return response, nil
+}
+
+// Download the rewards tree files for every completed interval from the given one onward
+func (c *Client) DownloadRewardsFiles(startInterval uint64) (api.DownloadRewardsFilesResponse, error) {
+	responseBytes, err := c.callAPI(fmt.Sprintf("network download-rewards-files %d", startInterval))
+	if err != nil {
+		return api.DownloadRewardsFilesResponse{}, fmt.Errorf("Could not download rewards files: %w", err)
+	}
+	var response api.DownloadRewardsFilesResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.DownloadRewardsFilesResponse{}, fmt.Errorf("Could not decode download rewards files response: %w", err)
+	}
+	if response.Error != "" {
+		return api.DownloadRewardsFilesResponse{}, fmt.Errorf("Could not download rewards files: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Get the current RPL price from the price oracle
+func (c *Client) OracleRplPrice() (api.OracleRplPriceResponse, error) {
+	responseBytes, err := c.callAPI("network oracle-rpl-price")
+	if err != nil {
+		return api.OracleRplPriceResponse{}, fmt.Errorf("Could not get oracle RPL price: %w", err)
+	}
+	var response api.OracleRplPriceResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.OracleRplPriceResponse{}, fmt.Errorf("Could not decode oracle RPL price response: %w", err)
+	}
+	if response.Error != "" {
+		return api.OracleRplPriceResponse{}, fmt.Errorf("Could not get oracle RPL price: %s", response.Error)
+	}
+	if response.RplPrice == nil {
+		response.RplPrice = big.NewInt(0)
+	}
+	return response, nil
+}
+
 // Get network stats
 func (c *Client) NetworkStats() (api.NetworkStatsResponse, error) {
 	responseBytes, err := c.callAPI("network stats")
@@ -98,8 +155,12 @@ func (c *Client) CanGenerateRewardsTree(index uint64) (api.CanNetworkGenerateRew
 }
 
 // Set a request marker for the watchtower to generate the rewards tree for the given interval
-func (c *Client) GenerateRewardsTree(index uint64) (api.NetworkGenerateRewardsTreeResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("network generate-rewards-tree %d", index))
+func (c *Client) GenerateRewardsTree(index uint64, dryRun bool) (api.NetworkGenerateRewardsTreeResponse, error) {
+	command := "network generate-rewards-tree "
+	if dryRun {
+		command += "--dry-run "
+	}
+	responseBytes, err := c.callAPI(fmt.Sprintf("%s%d", command, index))
 	if err != nil {
 		return api.NetworkGenerateRewardsTreeResponse{}, fmt.Errorf("Could not initialize rewards tree generation: %w", err)
 	}
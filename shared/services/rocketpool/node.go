@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/ethereum/go-ethereum/common"
+	rptypes "github.com/rocket-pool/rocketpool-go/types"
 
 	"github.com/rocket-pool/smartnode/shared/types/api"
 )
@@ -74,6 +75,9 @@ func (c *Client) CanRegisterNode(timezoneLocation string) (api.CanRegisterNodeRe
 	if response.Error != "" {
 		return api.CanRegisterNodeResponse{}, fmt.Errorf("Could not get can register node status: %s", response.Error)
 	}
+	if response.NodeBalance == nil {
+		response.NodeBalance = big.NewInt(0)
+	}
 	return response, nil
 }
 
@@ -445,9 +449,17 @@ func (c *Client) NodeDeposit(amountWei *big.Int, minFee float64, salt *big.Int)
 	return response, nil
 }
 
+// Get the API amount argument for a send, where a nil amount sends the node's full balance
+func getSendAmountArg(amountWei *big.Int) string {
+	if amountWei == nil {
+		return "max"
+	}
+	return amountWei.String()
+}
+
 // Check whether the node can send tokens
 func (c *Client) CanNodeSend(amountWei *big.Int, token string) (api.CanNodeSendResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("node can-send %s %s", amountWei.String(), token))
+	responseBytes, err := c.callAPI(fmt.Sprintf("node can-send %s %s", getSendAmountArg(amountWei), token))
 	if err != nil {
 		return api.CanNodeSendResponse{}, fmt.Errorf("Could not get can node send status: %w", err)
 	}
@@ -458,12 +470,15 @@ func (c *Client) CanNodeSend(amountWei *big.Int, token string) (api.CanNodeSendR
 	if response.Error != "" {
 		return api.CanNodeSendResponse{}, fmt.Errorf("Could not get can node send status: %s", response.Error)
 	}
+	if response.Amount == nil {
+		response.Amount = big.NewInt(0)
+	}
 	return response, nil
 }
 
 // Send tokens from the node to an address
 func (c *Client) NodeSend(amountWei *big.Int, token string, toAddress common.Address) (api.NodeSendResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("node send %s %s %s", amountWei.String(), token, toAddress.Hex()))
+	responseBytes, err := c.callAPI(fmt.Sprintf("node send %s %s %s", getSendAmountArg(amountWei), token, toAddress.Hex()))
 	if err != nil {
 		return api.NodeSendResponse{}, fmt.Errorf("Could not send tokens from node: %w", err)
 	}
@@ -474,6 +489,9 @@ func (c *Client) NodeSend(amountWei *big.Int, token string, toAddress common.Add
 	if response.Error != "" {
 		return api.NodeSendResponse{}, fmt.Errorf("Could not send tokens from node: %s", response.Error)
 	}
+	if response.Amount == nil {
+		response.Amount = big.NewInt(0)
+	}
 	return response, nil
 }
 
@@ -573,6 +591,47 @@ func (c *Client) NodeRewards() (api.NodeRewardsResponse, error) {
 	return response, nil
 }
 
+// Estimate the node's provisional rewards for the current interval
+func (c *Client) EstimateRewards() (api.NodeEstimateRewardsResponse, error) {
+	responseBytes, err := c.callAPI("node estimate-rewards")
+	if err != nil {
+		return api.NodeEstimateRewardsResponse{}, fmt.Errorf("Could not estimate node rewards: %w", err)
+	}
+	var response api.NodeEstimateRewardsResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.NodeEstimateRewardsResponse{}, fmt.Errorf("Could not decode estimate node rewards response: %w", err)
+	}
+	if response.Error != "" {
+		return api.NodeEstimateRewardsResponse{}, fmt.Errorf("Could not estimate node rewards: %s", response.Error)
+	}
+	if response.CollateralRpl == nil {
+		response.CollateralRpl = big.NewInt(0)
+	}
+	if response.OracleDaoRpl == nil {
+		response.OracleDaoRpl = big.NewInt(0)
+	}
+	if response.SmoothingPoolEth == nil {
+		response.SmoothingPoolEth = big.NewInt(0)
+	}
+	return response, nil
+}
+
+// Check that a signed deposit is valid for the current network, and get its deposit data root
+func (c *Client) ValidateDepositData(pubkey rptypes.ValidatorPubkey, withdrawalCredentials common.Hash, amountGwei uint64, signature rptypes.ValidatorSignature) (api.ValidateDepositDataResponse, error) {
+	responseBytes, err := c.callAPI(fmt.Sprintf("node validate-deposit-data %s %s %d %s", pubkey.Hex(), withdrawalCredentials.Hex(), amountGwei, signature.Hex()))
+	if err != nil {
+		return api.ValidateDepositDataResponse{}, fmt.Errorf("Could not validate deposit data: %w", err)
+	}
+	var response api.ValidateDepositDataResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.ValidateDepositDataResponse{}, fmt.Errorf("Could not decode validate deposit data response: %w", err)
+	}
+	if response.Error != "" {
+		return api.ValidateDepositDataResponse{}, fmt.Errorf("Could not validate deposit data: %s", response.Error)
+	}
+	return response, nil
+}
+
 // Get the deposit contract info for Rocket Pool and the Beacon Client
 func (c *Client) DepositContractInfo() (api.DepositContractInfoResponse, error) {
 	responseBytes, err := c.callAPI("node deposit-contract-info")
@@ -701,9 +760,13 @@ func (c *Client) InitializeFeeDistributor() (api.NodeInitializeFeeDistributorRes
 	return response, nil
 }
 
-// Check if distributing ETH from the node's fee distributor is possible
-func (c *Client) CanDistribute() (api.NodeCanDistributeResponse, error) {
-	responseBytes, err := c.callAPI("node can-distribute")
+// Check if distributing ETH from the node's fee distributor is possible, optionally keeping a minimum balance in the node wallet
+func (c *Client) CanDistribute(reserveWei *big.Int) (api.NodeCanDistributeResponse, error) {
+	command := "node can-distribute"
+	if reserveWei != nil {
+		command = fmt.Sprintf("node can-distribute --reserve-wei %s", reserveWei.String())
+	}
+	responseBytes, err := c.callAPI(command)
 	if err != nil {
 		return api.NodeCanDistributeResponse{}, fmt.Errorf("Could not get can distribute: %w", err)
 	}
@@ -714,6 +777,54 @@ func (c *Client) CanDistribute() (api.NodeCanDistributeResponse, error) {
 	if response.Error != "" {
 		return api.NodeCanDistributeResponse{}, fmt.Errorf("Could not get can distribute: %s", response.Error)
 	}
+	if response.Balance == nil {
+		response.Balance = big.NewInt(0)
+	}
+	if response.NodeShare == nil {
+		response.NodeShare = big.NewInt(0)
+	}
+	if response.UserShare == nil {
+		response.UserShare = big.NewInt(0)
+	}
+	if response.NodeBalance == nil {
+		response.NodeBalance = big.NewInt(0)
+	}
+	if response.GasCost == nil {
+		response.GasCost = big.NewInt(0)
+	}
+	return response, nil
+}
+
+// Get how much ETH the node can withdraw right now, with a breakdown by source
+func (c *Client) CanWithdrawEth() (api.NodeCanWithdrawEthResponse, error) {
+	responseBytes, err := c.callAPI("node can-withdraw-eth")
+	if err != nil {
+		return api.NodeCanWithdrawEthResponse{}, fmt.Errorf("Could not get withdrawable ETH: %w", err)
+	}
+	var response api.NodeCanWithdrawEthResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.NodeCanWithdrawEthResponse{}, fmt.Errorf("Could not decode withdrawable ETH response: %w", err)
+	}
+	if response.Error != "" {
+		return api.NodeCanWithdrawEthResponse{}, fmt.Errorf("Could not get withdrawable ETH: %s", response.Error)
+	}
+	if response.FeeDistributorShare == nil {
+		response.FeeDistributorShare = big.NewInt(0)
+	}
+	if response.MinipoolRefundsTotal == nil {
+		response.MinipoolRefundsTotal = big.NewInt(0)
+	}
+	if response.UnclaimedRewards == nil {
+		response.UnclaimedRewards = big.NewInt(0)
+	}
+	if response.TotalWithdrawable == nil {
+		response.TotalWithdrawable = big.NewInt(0)
+	}
+	for i := range response.MinipoolRefunds {
+		if response.MinipoolRefunds[i].Amount == nil {
+			response.MinipoolRefunds[i].Amount = big.NewInt(0)
+		}
+	}
 	return response, nil
 }
 
@@ -893,3 +1004,116 @@ func (c *Client) SignMessage(message string) (api.NodeSignResponse, error) {
 	}
 	return response, nil
 }
+
+// Use the node private key to sign a batch of arbitrary messages
+func (c *Client) SignMessages(messages []string) (api.NodeSignMessagesResponse, error) {
+	responseBytes, err := c.callAPI("node sign-messages", messages...)
+	if err != nil {
+		return api.NodeSignMessagesResponse{}, fmt.Errorf("Could not sign messages: %w", err)
+	}
+
+	var response api.NodeSignMessagesResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.NodeSignMessagesResponse{}, fmt.Errorf("Could not decode node sign messages response: %w", err)
+	}
+	if response.Error != "" {
+		return api.NodeSignMessagesResponse{}, fmt.Errorf("Could not sign messages: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Verify that a message was signed by the given address (EOA or EIP-1271 smart contract wallet)
+func (c *Client) VerifySignedMessage(message string, signature string, signer common.Address) (api.NodeVerifySignedMessageResponse, error) {
+	responseBytes, err := c.callAPI(fmt.Sprintf("node verify-signed-message %s %s", signature, signer.Hex()), message)
+	if err != nil {
+		return api.NodeVerifySignedMessageResponse{}, fmt.Errorf("Could not verify signed message: %w", err)
+	}
+
+	var response api.NodeVerifySignedMessageResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.NodeVerifySignedMessageResponse{}, fmt.Errorf("Could not decode verify signed message response: %w", err)
+	}
+	if response.Error != "" {
+		return api.NodeVerifySignedMessageResponse{}, fmt.Errorf("Could not verify signed message: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Get the node's RPL collateral ratio at each of the provided blocks
+func (c *Client) GetCollateralHistory(blockNumbers []uint64) (api.NodeGetCollateralHistoryResponse, error) {
+	blockNumberStrings := make([]string, len(blockNumbers))
+	for i, blockNumber := range blockNumbers {
+		blockNumberStrings[i] = fmt.Sprint(blockNumber)
+	}
+	responseBytes, err := c.callAPI(fmt.Sprintf("node get-collateral-history %s", strings.Join(blockNumberStrings, ",")))
+	if err != nil {
+		return api.NodeGetCollateralHistoryResponse{}, fmt.Errorf("Could not get collateral history: %w", err)
+	}
+	var response api.NodeGetCollateralHistoryResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.NodeGetCollateralHistoryResponse{}, fmt.Errorf("Could not decode collateral history response: %w", err)
+	}
+	if response.Error != "" {
+		return api.NodeGetCollateralHistoryResponse{}, fmt.Errorf("Could not get collateral history: %s", response.Error)
+	}
+	for i := range response.History {
+		snapshot := &response.History[i]
+		if snapshot.RplStake == nil {
+			snapshot.RplStake = big.NewInt(0)
+		}
+		if snapshot.MinimumRplStake == nil {
+			snapshot.MinimumRplStake = big.NewInt(0)
+		}
+		if snapshot.RplPrice == nil {
+			snapshot.RplPrice = big.NewInt(0)
+		}
+	}
+	return response, nil
+}
+
+// Get the node's RPL stake limits and the RPL needed to reach each of the given collateral percentages
+func (c *Client) GetRplStakeLimits(targetPercents []float64) (api.NodeGetRplStakeLimitsResponse, error) {
+	targetPercentStrings := make([]string, len(targetPercents))
+	for i, targetPercent := range targetPercents {
+		targetPercentStrings[i] = fmt.Sprint(targetPercent)
+	}
+	responseBytes, err := c.callAPI(fmt.Sprintf("node get-rpl-stake-limits %s", strings.Join(targetPercentStrings, ",")))
+	if err != nil {
+		return api.NodeGetRplStakeLimitsResponse{}, fmt.Errorf("Could not get RPL stake limits: %w", err)
+	}
+	var response api.NodeGetRplStakeLimitsResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.NodeGetRplStakeLimitsResponse{}, fmt.Errorf("Could not decode RPL stake limits response: %w", err)
+	}
+	if response.Error != "" {
+		return api.NodeGetRplStakeLimitsResponse{}, fmt.Errorf("Could not get RPL stake limits: %s", response.Error)
+	}
+	if response.RplStake == nil {
+		response.RplStake = big.NewInt(0)
+	}
+	if response.EffectiveRplStake == nil {
+		response.EffectiveRplStake = big.NewInt(0)
+	}
+	if response.MinimumRplStake == nil {
+		response.MinimumRplStake = big.NewInt(0)
+	}
+	if response.MaximumRplStake == nil {
+		response.MaximumRplStake = big.NewInt(0)
+	}
+	if response.RplPrice == nil {
+		response.RplPrice = big.NewInt(0)
+	}
+	for i := range response.Targets {
+		target := &response.Targets[i]
+		if target.RplStake == nil {
+			target.RplStake = big.NewInt(0)
+		}
+		if target.RplToStake == nil {
+			target.RplToStake = big.NewInt(0)
+		}
+		if target.RplToWithdraw == nil {
+			target.RplToWithdraw = big.NewInt(0)
+		}
+	}
+	return response, nil
+}
@@ -64,9 +64,25 @@ func (c *Client) TNDAOProposals() (api.TNDAOProposalsResponse, error) {
 	return response, nil
 }
 
+// Get a summary of the oracle DAO proposals that are currently open for voting
+func (c *Client) TNDAOActiveProposals() (api.TNDAOActiveProposalsResponse, error) {
+	responseBytes, err := c.callAPI("odao active-proposals")
+	if err != nil {
+		return api.TNDAOActiveProposalsResponse{}, fmt.Errorf("Could not get active oracle DAO proposals: %w", err)
+	}
+	var response api.TNDAOActiveProposalsResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.TNDAOActiveProposalsResponse{}, fmt.Errorf("Could not decode active oracle DAO proposals response: %w", err)
+	}
+	if response.Error != "" {
+		return api.TNDAOActiveProposalsResponse{}, fmt.Errorf("Could not get active oracle DAO proposals: %s", response.Error)
+	}
+	return response, nil
+}
+
 // Get a single oracle DAO proposal
 func (c *Client) TNDAOProposal(id uint64) (api.TNDAOProposalResponse, error) {
-	responseBytes, err := c.callAPI("odao proposal-details", string(id))
+	responseBytes, err := c.callAPI(fmt.Sprintf("odao proposal-details %d", id))
 	if err != nil {
 		return api.TNDAOProposalResponse{}, fmt.Errorf("Could not get oracle DAO proposal: %w", err)
 	}
@@ -144,9 +160,10 @@ func (c *Client) ProposeLeaveTNDAO() (api.ProposeTNDAOLeaveResponse, error) {
 	return response, nil
 }
 
-// Check whether the node can propose replacing its position with a new member
-func (c *Client) CanProposeReplaceTNDAOMember(memberAddress common.Address, memberId, memberUrl string) (api.CanProposeTNDAOReplaceResponse, error) {
-	responseBytes, err := c.callAPI("odao can-propose-replace", memberAddress.Hex(), memberId, memberUrl)
+// Check whether the node can propose replacing a member with a new member, and get the proposals required to do so
+// The fine is only applied if the leaving member is kicked; it's ignored if the node is replacing itself
+func (c *Client) CanProposeReplaceTNDAOMember(leavingAddress common.Address, memberAddress common.Address, memberId, memberUrl string, fineAmountWei *big.Int) (api.CanProposeTNDAOReplaceResponse, error) {
+	responseBytes, err := c.callAPI("odao can-propose-replace", leavingAddress.Hex(), memberAddress.Hex(), memberId, memberUrl, fineAmountWei.String())
 	if err != nil {
 		return api.CanProposeTNDAOReplaceResponse{}, fmt.Errorf("Could not get can propose replacing oracle DAO member status: %w", err)
 	}
@@ -160,20 +177,41 @@ func (c *Client) CanProposeReplaceTNDAOMember(memberAddress common.Address, memb
 	return response, nil
 }
 
-// Propose replacing the node's position with a new member
-func (c *Client) ProposeReplaceTNDAOMember(memberAddress common.Address, memberId, memberUrl string) (api.ProposeTNDAOReplaceResponse, error) {
-	responseBytes, err := c.callAPI("odao propose-replace", memberAddress.Hex(), memberId, memberUrl)
-	if err != nil {
-		return api.ProposeTNDAOReplaceResponse{}, fmt.Errorf("Could not propose replacing oracle DAO member: %w", err)
-	}
-	var response api.ProposeTNDAOReplaceResponse
-	if err := json.Unmarshal(responseBytes, &response); err != nil {
-		return api.ProposeTNDAOReplaceResponse{}, fmt.Errorf("Could not decode propose replacing oracle DAO member response: %w", err)
-	}
-	if response.Error != "" {
-		return api.ProposeTNDAOReplaceResponse{}, fmt.Errorf("Could not propose replacing oracle DAO member: %s", response.Error)
-	}
-	return response, nil
+// Submit one of the proposals returned by CanProposeReplaceTNDAOMember
+// The proposal cooldown applies between them, so they have to be submitted one at a time, in order
+func (c *Client) ProposeTNDAOReplacement(proposal api.TNDAOReplacementProposal) (api.ProposeTNDAOReplaceResponse, error) {
+	var proposalId uint64
+	var txHash common.Hash
+	switch proposal.Type {
+	case "invite":
+		response, err := c.ProposeInviteToTNDAO(proposal.MemberAddress, proposal.MemberId, proposal.MemberUrl)
+		if err != nil {
+			return api.ProposeTNDAOReplaceResponse{}, err
+		}
+		proposalId, txHash = response.ProposalId, response.TxHash
+	case "leave":
+		response, err := c.ProposeLeaveTNDAO()
+		if err != nil {
+			return api.ProposeTNDAOReplaceResponse{}, err
+		}
+		proposalId, txHash = response.ProposalId, response.TxHash
+	case "kick":
+		if proposal.FineAmount == nil {
+			return api.ProposeTNDAOReplaceResponse{}, fmt.Errorf("Could not propose kicking oracle DAO member: the kick proposal has no fine amount")
+		}
+		response, err := c.ProposeKickFromTNDAO(proposal.MemberAddress, proposal.FineAmount)
+		if err != nil {
+			return api.ProposeTNDAOReplaceResponse{}, err
+		}
+		proposalId, txHash = response.ProposalId, response.TxHash
+	default:
+		return api.ProposeTNDAOReplaceResponse{}, fmt.Errorf("Unknown oracle DAO replacement proposal type '%s'", proposal.Type)
+	}
+	return api.ProposeTNDAOReplaceResponse{
+		Status:     "success",
+		ProposalId: proposalId,
+		TxHash:     txHash,
+	}, nil
 }
 
 // Check whether the node can propose kicking a member
@@ -0,0 +1,56 @@
+package rocketpool
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+// Get the protocol DAO's bootstrap mode status
+func (c *Client) PDAOBootstrapStatus() (api.PDAOBootstrapStatusResponse, error) {
+	responseBytes, err := c.callAPI("pdao bootstrap-status")
+	if err != nil {
+		return api.PDAOBootstrapStatusResponse{}, fmt.Errorf("Could not get protocol DAO bootstrap status: %w", err)
+	}
+	var response api.PDAOBootstrapStatusResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.PDAOBootstrapStatusResponse{}, fmt.Errorf("Could not decode protocol DAO bootstrap status response: %w", err)
+	}
+	if response.Error != "" {
+		return api.PDAOBootstrapStatusResponse{}, fmt.Errorf("Could not get protocol DAO bootstrap status: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Check whether the node can change a protocol DAO setting via bootstrap mode
+func (c *Client) CanPDAOBootstrapSetting(contractName string, settingPath string, valueType string, value string) (api.CanPDAOBootstrapSettingResponse, error) {
+	responseBytes, err := c.callAPI("pdao can-bootstrap-setting", contractName, settingPath, valueType, value)
+	if err != nil {
+		return api.CanPDAOBootstrapSettingResponse{}, fmt.Errorf("Could not get can bootstrap protocol DAO setting status: %w", err)
+	}
+	var response api.CanPDAOBootstrapSettingResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.CanPDAOBootstrapSettingResponse{}, fmt.Errorf("Could not decode can bootstrap protocol DAO setting response: %w", err)
+	}
+	if response.Error != "" {
+		return api.CanPDAOBootstrapSettingResponse{}, fmt.Errorf("Could not get can bootstrap protocol DAO setting status: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Change a protocol DAO setting via bootstrap mode
+func (c *Client) PDAOBootstrapSetting(contractName string, settingPath string, valueType string, value string) (api.PDAOBootstrapSettingResponse, error) {
+	responseBytes, err := c.callAPI("pdao bootstrap-setting", contractName, settingPath, valueType, value)
+	if err != nil {
+		return api.PDAOBootstrapSettingResponse{}, fmt.Errorf("Could not bootstrap protocol DAO setting: %w", err)
+	}
+	var response api.PDAOBootstrapSettingResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.PDAOBootstrapSettingResponse{}, fmt.Errorf("Could not decode bootstrap protocol DAO setting response: %w", err)
+	}
+	if response.Error != "" {
+		return api.PDAOBootstrapSettingResponse{}, fmt.Errorf("Could not bootstrap protocol DAO setting: %s", response.Error)
+	}
+	return response, nil
+}
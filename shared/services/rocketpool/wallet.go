@@ -3,8 +3,10 @@ package rocketpool
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/rocketpool-go/types"
 	"github.com/rocket-pool/smartnode/shared/types/api"
 )
 
@@ -24,6 +26,22 @@ func (c *Client) WalletStatus() (api.WalletStatusResponse, error) {
 	return response, nil
 }
 
+// Get the location and state of each of the wallet's files
+func (c *Client) WalletStatusDetails() (api.WalletStatusDetailsResponse, error) {
+	responseBytes, err := c.callAPI("wallet status-details")
+	if err != nil {
+		return api.WalletStatusDetailsResponse{}, fmt.Errorf("Could not get wallet status details: %w", err)
+	}
+	var response api.WalletStatusDetailsResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.WalletStatusDetailsResponse{}, fmt.Errorf("Could not decode wallet status details response: %w", err)
+	}
+	if response.Error != "" {
+		return api.WalletStatusDetailsResponse{}, fmt.Errorf("Could not get wallet status details: %s", response.Error)
+	}
+	return response, nil
+}
+
 // Set wallet password
 func (c *Client) SetPassword(password string) (api.SetPasswordResponse, error) {
 	responseBytes, err := c.callAPI("wallet set-password", password)
@@ -41,8 +59,8 @@ func (c *Client) SetPassword(password string) (api.SetPasswordResponse, error) {
 }
 
 // Initialize wallet
-func (c *Client) InitWallet(derivationPath string) (api.InitWalletResponse, error) {
-	responseBytes, err := c.callAPI("wallet init --derivation-path", derivationPath)
+func (c *Client) InitWallet(derivationPath string, entropyBits int, passphrase string) (api.InitWalletResponse, error) {
+	responseBytes, err := c.callAPI(fmt.Sprintf("wallet init --entropy-bits %d --mnemonic-passphrase", entropyBits), passphrase, "--derivation-path", derivationPath)
 	if err != nil {
 		return api.InitWalletResponse{}, fmt.Errorf("Could not initialize wallet: %w", err)
 	}
@@ -57,7 +75,7 @@ func (c *Client) InitWallet(derivationPath string) (api.InitWalletResponse, erro
 }
 
 // Recover wallet
-func (c *Client) RecoverWallet(mnemonic string, skipValidatorKeyRecovery bool, derivationPath string, walletIndex uint) (api.RecoverWalletResponse, error) {
+func (c *Client) RecoverWallet(mnemonic string, passphrase string, skipValidatorKeyRecovery bool, derivationPath string, walletIndex uint) (api.RecoverWalletResponse, error) {
 	command := "wallet recover "
 	if skipValidatorKeyRecovery {
 		command += "--skip-validator-key-recovery "
@@ -65,9 +83,9 @@ func (c *Client) RecoverWallet(mnemonic string, skipValidatorKeyRecovery bool, d
 	if walletIndex != 0 {
 		command += fmt.Sprintf("--wallet-index %d ", walletIndex)
 	}
-	command += "--derivation-path"
+	command += "--mnemonic-passphrase"
 
-	responseBytes, err := c.callAPI(command, derivationPath, mnemonic)
+	responseBytes, err := c.callAPI(command, passphrase, "--derivation-path", derivationPath, mnemonic)
 	if err != nil {
 		return api.RecoverWalletResponse{}, fmt.Errorf("Could not recover wallet: %w", err)
 	}
@@ -82,13 +100,19 @@ func (c *Client) RecoverWallet(mnemonic string, skipValidatorKeyRecovery bool, d
 }
 
 // Search and recover wallet
-func (c *Client) SearchAndRecoverWallet(mnemonic string, address common.Address, skipValidatorKeyRecovery bool) (api.SearchAndRecoverWalletResponse, error) {
+func (c *Client) SearchAndRecoverWallet(mnemonic string, passphrase string, address common.Address, skipValidatorKeyRecovery bool, customDerivationPath string) (api.SearchAndRecoverWalletResponse, error) {
 	command := "wallet search-and-recover "
 	if skipValidatorKeyRecovery {
 		command += "--skip-validator-key-recovery "
 	}
+	command += "--mnemonic-passphrase"
 
-	responseBytes, err := c.callAPI(command, mnemonic, address.Hex())
+	otherArgs := []string{passphrase}
+	if customDerivationPath != "" {
+		otherArgs = append(otherArgs, "--derivation-path", "custom:"+customDerivationPath)
+	}
+	otherArgs = append(otherArgs, mnemonic, address.Hex())
+	responseBytes, err := c.callAPI(command, otherArgs...)
 	if err != nil {
 		return api.SearchAndRecoverWalletResponse{}, fmt.Errorf("Could not search and recover wallet: %w", err)
 	}
@@ -103,7 +127,7 @@ func (c *Client) SearchAndRecoverWallet(mnemonic string, address common.Address,
 }
 
 // Recover wallet
-func (c *Client) TestRecoverWallet(mnemonic string, skipValidatorKeyRecovery bool, derivationPath string, walletIndex uint) (api.RecoverWalletResponse, error) {
+func (c *Client) TestRecoverWallet(mnemonic string, passphrase string, skipValidatorKeyRecovery bool, derivationPath string, walletIndex uint) (api.RecoverWalletResponse, error) {
 	command := "wallet test-recovery "
 	if skipValidatorKeyRecovery {
 		command += "--skip-validator-key-recovery "
@@ -111,9 +135,9 @@ func (c *Client) TestRecoverWallet(mnemonic string, skipValidatorKeyRecovery boo
 	if walletIndex != 0 {
 		command += fmt.Sprintf("--wallet-index %d ", walletIndex)
 	}
-	command += "--derivation-path"
+	command += "--mnemonic-passphrase"
 
-	responseBytes, err := c.callAPI(command, derivationPath, mnemonic)
+	responseBytes, err := c.callAPI(command, passphrase, "--derivation-path", derivationPath, mnemonic)
 	if err != nil {
 		return api.RecoverWalletResponse{}, fmt.Errorf("Could not test recover wallet: %w", err)
 	}
@@ -128,13 +152,19 @@ func (c *Client) TestRecoverWallet(mnemonic string, skipValidatorKeyRecovery boo
 }
 
 // Search and recover wallet
-func (c *Client) TestSearchAndRecoverWallet(mnemonic string, address common.Address, skipValidatorKeyRecovery bool) (api.SearchAndRecoverWalletResponse, error) {
+func (c *Client) TestSearchAndRecoverWallet(mnemonic string, passphrase string, address common.Address, skipValidatorKeyRecovery bool, customDerivationPath string) (api.SearchAndRecoverWalletResponse, error) {
 	command := "wallet test-search-and-recover "
 	if skipValidatorKeyRecovery {
 		command += "--skip-validator-key-recovery "
 	}
+	command += "--mnemonic-passphrase"
 
-	responseBytes, err := c.callAPI(command, mnemonic, address.Hex())
+	otherArgs := []string{passphrase}
+	if customDerivationPath != "" {
+		otherArgs = append(otherArgs, "--derivation-path", "custom:"+customDerivationPath)
+	}
+	otherArgs = append(otherArgs, mnemonic, address.Hex())
+	responseBytes, err := c.callAPI(command, otherArgs...)
 	if err != nil {
 		return api.SearchAndRecoverWalletResponse{}, fmt.Errorf("Could not test search and recover wallet: %w", err)
 	}
@@ -148,6 +178,51 @@ func (c *Client) TestSearchAndRecoverWallet(mnemonic string, address common.Addr
 	return response, nil
 }
 
+// Export an encrypted backup of the wallet
+func (c *Client) ExportWalletBackup(passphrase string, includeValidatorKeys bool) (api.ExportWalletBackupResponse, error) {
+	command := "wallet export-backup "
+	if includeValidatorKeys {
+		command += "--include-validator-keys "
+	}
+	responseBytes, err := c.callAPI(command, passphrase)
+	if err != nil {
+		return api.ExportWalletBackupResponse{}, fmt.Errorf("Could not export wallet backup: %w", err)
+	}
+	var response api.ExportWalletBackupResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.ExportWalletBackupResponse{}, fmt.Errorf("Could not decode export wallet backup response: %w", err)
+	}
+	if response.Error != "" {
+		return api.ExportWalletBackupResponse{}, fmt.Errorf("Could not export wallet backup: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Restore the wallet from an encrypted backup
+func (c *Client) ImportWalletBackup(backup []byte, passphrase string, force bool) (api.ImportWalletBackupResponse, error) {
+	backupPath, cleanup, err := c.writeTransferFile("wallet-backup-*.json", backup)
+	if err != nil {
+		return api.ImportWalletBackupResponse{}, fmt.Errorf("Could not import wallet backup: %w", err)
+	}
+	defer cleanup()
+	command := "wallet import-backup "
+	if force {
+		command += "--force "
+	}
+	responseBytes, err := c.callAPI(command, backupPath, passphrase)
+	if err != nil {
+		return api.ImportWalletBackupResponse{}, fmt.Errorf("Could not import wallet backup: %w", err)
+	}
+	var response api.ImportWalletBackupResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.ImportWalletBackupResponse{}, fmt.Errorf("Could not decode import wallet backup response: %w", err)
+	}
+	if response.Error != "" {
+		return api.ImportWalletBackupResponse{}, fmt.Errorf("Could not import wallet backup: %s", response.Error)
+	}
+	return response, nil
+}
+
 // Rebuild wallet
 func (c *Client) RebuildWallet() (api.RebuildWalletResponse, error) {
 	responseBytes, err := c.callAPI("wallet rebuild")
@@ -195,3 +270,60 @@ func (c *Client) ExportWallet() (api.ExportWalletResponse, error) {
 	}
 	return response, nil
 }
+
+// Get the ENS name and text records of the node address
+func (c *Client) GetEnsProfile() (api.WalletEnsProfileResponse, error) {
+	responseBytes, err := c.callAPI("wallet ens-profile")
+	if err != nil {
+		return api.WalletEnsProfileResponse{}, fmt.Errorf("Could not get ENS profile: %w", err)
+	}
+	var response api.WalletEnsProfileResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.WalletEnsProfileResponse{}, fmt.Errorf("Could not decode ENS profile response: %w", err)
+	}
+	if response.Error != "" {
+		return api.WalletEnsProfileResponse{}, fmt.Errorf("Could not get ENS profile: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Get the pubkeys and indices of the next validator keys the wallet will generate
+func (c *Client) PeekValidatorKeys(count uint) (api.PeekValidatorKeysResponse, error) {
+	responseBytes, err := c.callAPI(fmt.Sprintf("wallet peek-validator-keys %d", count))
+	if err != nil {
+		return api.PeekValidatorKeysResponse{}, fmt.Errorf("Could not get upcoming validator keys: %w", err)
+	}
+	var response api.PeekValidatorKeysResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.PeekValidatorKeysResponse{}, fmt.Errorf("Could not decode upcoming validator keys response: %w", err)
+	}
+	if response.Error != "" {
+		return api.PeekValidatorKeysResponse{}, fmt.Errorf("Could not get upcoming validator keys: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Export validator keys as EIP-2335 keystores, along with their passwords
+// If no pubkeys are provided, the keys for all of the node's validating minipools are exported
+func (c *Client) ExportValidatorKeys(pubkeys []types.ValidatorPubkey) (api.ExportValidatorKeysResponse, error) {
+	otherArgs := []string{}
+	if len(pubkeys) > 0 {
+		pubkeyStrings := make([]string, len(pubkeys))
+		for i, pubkey := range pubkeys {
+			pubkeyStrings[i] = pubkey.Hex()
+		}
+		otherArgs = append(otherArgs, strings.Join(pubkeyStrings, ","))
+	}
+	responseBytes, err := c.callAPI("wallet export-validator-keys", otherArgs...)
+	if err != nil {
+		return api.ExportValidatorKeysResponse{}, fmt.Errorf("Could not export validator keys: %w", err)
+	}
+	var response api.ExportValidatorKeysResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.ExportValidatorKeysResponse{}, fmt.Errorf("Could not decode export validator keys response: %w", err)
+	}
+	if response.Error != "" {
+		return api.ExportValidatorKeysResponse{}, fmt.Errorf("Could not export validator keys: %s", response.Error)
+	}
+	return response, nil
+}
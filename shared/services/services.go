@@ -1,12 +1,14 @@
 package services
 
 import (
+	"context"
 	"fmt"
 	"math/big"
 	"os"
 	"sync"
 
 	"github.com/docker/docker/client"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/rocket-pool/rocketpool-go/rocketpool"
 	"github.com/rocket-pool/rocketpool-go/utils/eth"
@@ -157,6 +159,40 @@ func GetDocker(c *cli.Context) (*client.Client, error) {
 	return getDocker()
 }
 
+// Get the call options for read-only queries; if the finalized flag is set, queries are pinned to the latest
+// finalized Execution layer block instead of the chain head so they can't be affected by reorgs
+func GetCallOpts(c *cli.Context) (*bind.CallOpts, error) {
+	opts, _, err := GetQueryOpts(c)
+	return opts, err
+}
+
+// Get the call options and the matching Beacon state for read-only queries; if the finalized flag is set, both are pinned
+// to the latest finalized Beacon block and its Execution layer block, otherwise both are nil and queries use the chain head
+func GetQueryOpts(c *cli.Context) (*bind.CallOpts, *beacon.ValidatorStatusOptions, error) {
+	if !c.GlobalBool("finalized") {
+		return nil, nil, nil
+	}
+	bc, err := GetBeaconClient(c)
+	if err != nil {
+		return nil, nil, err
+	}
+	block, exists, err := bc.GetBeaconBlock("finalized")
+	if err != nil {
+		return nil, nil, fmt.Errorf("Error getting the latest finalized Beacon block: %w", err)
+	}
+	if !exists || !block.HasExecutionPayload {
+		return nil, nil, fmt.Errorf("The latest finalized Beacon block does not have an Execution layer block")
+	}
+	slot := block.Slot
+	callOpts := &bind.CallOpts{
+		BlockNumber: big.NewInt(0).SetUint64(block.ExecutionBlockNumber),
+	}
+	statusOpts := &beacon.ValidatorStatusOptions{
+		Slot: &slot,
+	}
+	return callOpts, statusOpts, nil
+}
+
 //
 // Service instance getters
 //
@@ -208,6 +244,17 @@ func getWallet(c *cli.Context, cfg *config.RocketPoolConfig, pm *passwords.Passw
 			return
 		}
 
+		// Check the configured chain ID against the Execution client before signing
+		if cfg.Smartnode.VerifyChainID.Value == true {
+			nodeWallet.SetLiveChainIDGetter(func() (*big.Int, error) {
+				ec, err := getEthClient(c, cfg)
+				if err != nil {
+					return nil, err
+				}
+				return ec.ChainID(context.Background())
+			})
+		}
+
 		// Keystores
 		lighthouseKeystore := lhkeystore.NewKeystore(os.ExpandEnv(cfg.Smartnode.GetValidatorKeychainPath()), pm)
 		nimbusKeystore := nmkeystore.NewKeystore(os.ExpandEnv(cfg.Smartnode.GetValidatorKeychainPath()), pm)
@@ -10,6 +10,7 @@ import (
 	"path/filepath"
 
 	"github.com/google/uuid"
+	rptypes "github.com/rocket-pool/rocketpool-go/types"
 	rpkeystore "github.com/rocket-pool/smartnode/shared/services/wallet/keystore"
 	eth2types "github.com/wealdtech/go-eth2-types/v2"
 	eth2ks "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
@@ -161,6 +162,32 @@ func (ks *Keystore) StoreValidatorKey(key *eth2types.BLSPrivateKey, derivationPa
 
 }
 
+// Load a validator key, or nil if the key isn't in the keystore
+// The account store doesn't record derivation paths, so the path is always empty
+func (ks *Keystore) LoadValidatorKey(pubkey rptypes.ValidatorPubkey) (*eth2types.BLSPrivateKey, string, error) {
+
+	// Initialize the account store
+	if err := ks.initialize(); err != nil {
+		return nil, "", err
+	}
+
+	// Find the validator key in the account store
+	for ki := 0; ki < len(ks.as.PublicKeys); ki++ {
+		if !bytes.Equal(pubkey.Bytes(), ks.as.PublicKeys[ki]) {
+			continue
+		}
+		key, err := eth2types.BLSPrivateKeyFromBytes(ks.as.PrivateKeys[ki])
+		if err != nil {
+			return nil, "", fmt.Errorf("Could not recreate validator key: %w", err)
+		}
+		return key, "", nil
+	}
+
+	// Return
+	return nil, "", nil
+
+}
+
 // Initialize the account store
 func (ks *Keystore) initialize() error {
 
@@ -1,6 +1,7 @@
 package teku
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -119,3 +120,50 @@ func (ks *Keystore) StoreValidatorKey(key *eth2types.BLSPrivateKey, derivationPa
 	return nil
 
 }
+
+// Load a validator key and its derivation path, or nil if the key isn't in the keystore
+func (ks *Keystore) LoadValidatorKey(pubkey rptypes.ValidatorPubkey) (*eth2types.BLSPrivateKey, string, error) {
+
+	// Get key file path
+	keyFilePath := filepath.Join(ks.keystorePath, KeystoreDir, ValidatorsDir, hexutil.AddPrefix(pubkey.Hex())+".json")
+
+	// Read key store from disk
+	keyStoreBytes, err := ioutil.ReadFile(keyFilePath)
+	if os.IsNotExist(err) {
+		return nil, "", nil
+	} else if err != nil {
+		return nil, "", fmt.Errorf("Could not read validator key from disk: %w", err)
+	}
+
+	// Decode key store
+	keyStore := validatorKey{}
+	if err := json.Unmarshal(keyStoreBytes, &keyStore); err != nil {
+		return nil, "", fmt.Errorf("Could not decode validator key: %w", err)
+	}
+
+	// Read secret from disk
+	secretFilePath := filepath.Join(ks.keystorePath, KeystoreDir, SecretsDir, hexutil.AddPrefix(pubkey.Hex())+".txt")
+	password, err := ioutil.ReadFile(secretFilePath)
+	if err != nil {
+		return nil, "", fmt.Errorf("Could not read validator secret from disk: %w", err)
+	}
+
+	// Decrypt key
+	decryptedKey, err := ks.encryptor.Decrypt(keyStore.Crypto, string(password))
+	if err != nil {
+		return nil, "", fmt.Errorf("Could not decrypt validator key: %w", err)
+	}
+	key, err := eth2types.BLSPrivateKeyFromBytes(decryptedKey)
+	if err != nil {
+		return nil, "", fmt.Errorf("Could not recreate validator key: %w", err)
+	}
+
+	// Make sure it's the requested key
+	if !bytes.Equal(key.PublicKey().Marshal(), pubkey.Bytes()) {
+		return nil, "", fmt.Errorf("Validator key file %s does not contain the key for %s", keyFilePath, pubkey.Hex())
+	}
+
+	// Return
+	return key, keyStore.Path, nil
+
+}
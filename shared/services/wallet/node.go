@@ -53,6 +53,11 @@ func (w *Wallet) GetNodeAccountTransactor() (*bind.TransactOpts, error) {
 		return nil, errors.New("Wallet is not initialized")
 	}
 
+	// Make sure transactions will be valid on the connected chain
+	if err := w.verifyChainID(); err != nil {
+		return nil, err
+	}
+
 	// Get private key
 	privateKey, _, err := w.getNodePrivateKey()
 	if err != nil {
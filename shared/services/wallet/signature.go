@@ -0,0 +1,108 @@
+package wallet
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/rocket-pool/rocketpool-go/rocketpool"
+)
+
+// The EIP-1271 isValidSignature function
+const eip1271Abi = `[
+    {
+      "inputs": [
+        {
+          "internalType": "bytes32",
+          "name": "hash",
+          "type": "bytes32"
+        },
+        {
+          "internalType": "bytes",
+          "name": "signature",
+          "type": "bytes"
+        }
+      ],
+      "name": "isValidSignature",
+      "outputs": [
+        {
+          "internalType": "bytes4",
+          "name": "magicValue",
+          "type": "bytes4"
+        }
+      ],
+      "stateMutability": "view",
+      "type": "function"
+    }
+  ]`
+
+// The value returned by isValidSignature for a valid signature
+var eip1271MagicValue = [4]byte{0x16, 0x26, 0xba, 0x7e}
+
+// Verifies that a message signed with SignMessage (or any other personal_sign signature) was signed by the given address.
+// EOA signatures are checked with ecrecover; if that fails and the signer is a contract (such as a Gnosis Safe),
+// the signature is checked on-chain with EIP-1271's isValidSignature.
+func VerifySignature(ec rocketpool.ExecutionClient, message string, signature []byte, signer common.Address) (bool, error) {
+
+	messageHash := accounts.TextHash([]byte(message))
+
+	// Try recovering an EOA signer
+	if len(signature) == crypto.SignatureLength {
+		sig := make([]byte, crypto.SignatureLength)
+		copy(sig, signature)
+		if sig[crypto.RecoveryIDOffset] >= 27 {
+			sig[crypto.RecoveryIDOffset] -= 27
+		}
+		pubkey, err := crypto.SigToPub(messageHash, sig)
+		if err == nil && crypto.PubkeyToAddress(*pubkey) == signer {
+			return true, nil
+		}
+	}
+
+	// Fall back to EIP-1271 if the signer is a contract
+	code, err := ec.CodeAt(context.Background(), signer, nil)
+	if err != nil {
+		return false, fmt.Errorf("Error getting code for signer %s: %w", signer.Hex(), err)
+	}
+	if len(code) == 0 {
+		return false, nil
+	}
+
+	parsed, err := abi.JSON(strings.NewReader(eip1271Abi))
+	if err != nil {
+		return false, fmt.Errorf("Error decoding EIP-1271 ABI: %w", err)
+	}
+	var hash [32]byte
+	copy(hash[:], messageHash)
+	input, err := parsed.Pack("isValidSignature", hash, signature)
+	if err != nil {
+		return false, fmt.Errorf("Error encoding isValidSignature call: %w", err)
+	}
+	output, err := ec.CallContract(context.Background(), ethereum.CallMsg{
+		To:   &signer,
+		Data: input,
+	}, nil)
+	if err != nil {
+		// Contracts that don't implement EIP-1271 (or reject the signature) revert; anything else is a failure to check it
+		if strings.Contains(err.Error(), "execution reverted") {
+			return false, nil
+		}
+		return false, fmt.Errorf("Error calling isValidSignature on signer %s: %w", signer.Hex(), err)
+	}
+	results, err := parsed.Unpack("isValidSignature", output)
+	if err != nil || len(results) == 0 {
+		return false, nil
+	}
+	magicValue, ok := results[0].([4]byte)
+	if !ok {
+		return false, nil
+	}
+	return bytes.Equal(magicValue[:], eip1271MagicValue[:]), nil
+
+}
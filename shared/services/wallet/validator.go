@@ -2,14 +2,23 @@ package wallet
 
 import (
 	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"os"
+	"path/filepath"
+	"sort"
+	"strings"
 	"sync"
 
+	"github.com/google/uuid"
 	rptypes "github.com/rocket-pool/rocketpool-go/types"
 	eth2types "github.com/wealdtech/go-eth2-types/v2"
 	eth2util "github.com/wealdtech/go-eth2-util"
+	eth2ks "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+
+	"github.com/rocket-pool/smartnode/shared/services/wallet/keystore"
 )
 
 // Config
@@ -53,15 +62,72 @@ func (w *Wallet) GetValidatorKeyByPubkey(pubkey rptypes.ValidatorPubkey) (*eth2t
 		return nil, errors.New("Wallet is not initialized")
 	}
 
+	// Find the validator key
+	validatorKey, _, err := w.findValidatorKey(pubkey)
+	if err != nil {
+		return nil, err
+	}
+	if validatorKey == nil {
+		return nil, fmt.Errorf("Validator %s key not found", pubkey.Hex())
+	}
+
+	// Return
+	return validatorKey, nil
+
+}
+
+// Get a validator key and its derivation path by public key, or nil if the node doesn't have the key
+// Keys that weren't derived from the wallet's mnemonic (e.g. imported keys) are loaded from the validator keystores
+func (w *Wallet) LoadValidatorKey(pubkey rptypes.ValidatorPubkey) (*eth2types.BLSPrivateKey, string, error) {
+
+	// Check wallet is initialized
+	if !w.IsInitialized() {
+		return nil, "", errors.New("Wallet is not initialized")
+	}
+
+	// Check the keys derived from the mnemonic first
+	key, index, err := w.findValidatorKey(pubkey)
+	if err != nil {
+		return nil, "", err
+	}
+	if key != nil {
+		return key, fmt.Sprintf(ValidatorKeyPath, index), nil
+	}
+
+	// Sort the keystore names so the keystores are always checked in the same order
+	names := make([]string, 0, len(w.keystores))
+	for name := range w.keystores {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	// Fall back to the keys stored in the validator keystores
+	for _, name := range names {
+		key, derivationPath, err := w.keystores[name].LoadValidatorKey(pubkey)
+		if err != nil {
+			return nil, "", fmt.Errorf("Could not load %s validator key: %w", name, err)
+		}
+		if key != nil {
+			return key, derivationPath, nil
+		}
+	}
+
+	return nil, "", nil
+
+}
+
+// Find the validator key derived from the wallet's mnemonic for a public key, or nil if there isn't one
+func (w *Wallet) findValidatorKey(pubkey rptypes.ValidatorPubkey) (*eth2types.BLSPrivateKey, uint, error) {
+
 	// Get pubkey hex string
 	pubkeyHex := pubkey.Hex()
 
 	// Check for cached validator key index
 	if index, ok := w.validatorKeyIndices[pubkeyHex]; ok {
 		if key, _, err := w.getValidatorPrivateKey(index); err != nil {
-			return nil, err
+			return nil, 0, err
 		} else if bytes.Equal(pubkey.Bytes(), key.PublicKey().Marshal()) {
-			return key, nil
+			return key, index, nil
 		}
 	}
 
@@ -70,7 +136,7 @@ func (w *Wallet) GetValidatorKeyByPubkey(pubkey rptypes.ValidatorPubkey) (*eth2t
 	var validatorKey *eth2types.BLSPrivateKey
 	for index = 0; index < w.ws.NextAccount; index++ {
 		if key, _, err := w.getValidatorPrivateKey(index); err != nil {
-			return nil, err
+			return nil, 0, err
 		} else if bytes.Equal(pubkey.Bytes(), key.PublicKey().Marshal()) {
 			validatorKey = key
 			break
@@ -79,14 +145,14 @@ func (w *Wallet) GetValidatorKeyByPubkey(pubkey rptypes.ValidatorPubkey) (*eth2t
 
 	// Check validator key
 	if validatorKey == nil {
-		return nil, fmt.Errorf("Validator %s key not found", pubkeyHex)
+		return nil, 0, nil
 	}
 
 	// Cache validator key index
 	w.validatorKeyIndices[pubkeyHex] = index
 
 	// Return
-	return validatorKey, nil
+	return validatorKey, index, nil
 
 }
 
@@ -170,6 +236,39 @@ func (w *Wallet) GetNextValidatorKey() (*eth2types.BLSPrivateKey, error) {
 
 }
 
+// A validator key that hasn't been generated yet
+type ValidatorKeyPreview struct {
+	Index  uint
+	Pubkey rptypes.ValidatorPubkey
+}
+
+// Returns the public keys of the next validator keys that will be generated, without saving them or advancing the key counter
+func (w *Wallet) PeekValidatorKeys(count uint) ([]ValidatorKeyPreview, error) {
+
+	// Check wallet is initialized
+	if !w.IsInitialized() {
+		return nil, errors.New("Wallet is not initialized")
+	}
+
+	// Derive each key
+	previews := make([]ValidatorKeyPreview, count)
+	for i := uint(0); i < count; i++ {
+		index := w.ws.NextAccount + i
+		key, _, err := w.getValidatorPrivateKey(index)
+		if err != nil {
+			return nil, err
+		}
+		previews[i] = ValidatorKeyPreview{
+			Index:  index,
+			Pubkey: rptypes.BytesToValidatorPubkey(key.PublicKey().Marshal()),
+		}
+	}
+
+	// Return the previews
+	return previews, nil
+
+}
+
 // Recover a validator key by public key
 func (w *Wallet) RecoverValidatorKey(pubkey rptypes.ValidatorPubkey, startIndex uint) (uint, error) {
 
@@ -246,6 +345,128 @@ func (w *Wallet) TestRecoverValidatorKey(pubkey rptypes.ValidatorPubkey, startIn
 
 }
 
+// A validator pubkey that has more than one key file in the same validator keystore
+type DuplicateValidatorKey struct {
+	Keystore string
+	Pubkey   string
+	Paths    []string
+}
+
+// Scans each validator keystore for pubkeys that have more than one key file in it
+// Every keystore is expected to contain every key once, since each validator client only loads its own keystore;
+// a second copy of a key in the same keystore (e.g. one that was copied in by hand) would be loaded twice and could be slashed
+func (w *Wallet) CheckForDuplicateValidatorKeys() ([]DuplicateValidatorKey, error) {
+
+	duplicates := []DuplicateValidatorKey{}
+
+	// Sort the keystore names so the results are always in the same order
+	names := make([]string, 0, len(w.keystores))
+	for name := range w.keystores {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		keystoreDir := w.keystores[name].GetKeystoreDir()
+		keyFiles := map[string][]string{}
+		pubkeys := []string{}
+
+		// Find every key file in the keystore that declares a pubkey
+		err := filepath.Walk(keystoreDir, func(path string, info os.FileInfo, err error) error {
+			if err != nil {
+				if os.IsNotExist(err) {
+					return nil
+				}
+				return err
+			}
+			if info.IsDir() || filepath.Ext(path) != ".json" {
+				return nil
+			}
+
+			bytes, err := ioutil.ReadFile(path)
+			if err != nil {
+				return fmt.Errorf("error reading %s: %w", path, err)
+			}
+			var keyFile struct {
+				Pubkey string `json:"pubkey"`
+			}
+			if err := json.Unmarshal(bytes, &keyFile); err != nil || keyFile.Pubkey == "" {
+				// Not a validator key file
+				return nil
+			}
+
+			pubkey := strings.ToLower(strings.TrimPrefix(keyFile.Pubkey, "0x"))
+			if _, exists := keyFiles[pubkey]; !exists {
+				pubkeys = append(pubkeys, pubkey)
+			}
+			keyFiles[pubkey] = append(keyFiles[pubkey], path)
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error scanning %s validator keystore: %w", name, err)
+		}
+
+		for _, pubkey := range pubkeys {
+			if len(keyFiles[pubkey]) > 1 {
+				duplicates = append(duplicates, DuplicateValidatorKey{
+					Keystore: name,
+					Pubkey:   pubkey,
+					Paths:    keyFiles[pubkey],
+				})
+			}
+		}
+	}
+
+	return duplicates, nil
+
+}
+
+// Encrypt a validator key into a new EIP-2335 keystore with a random password, returning the keystore JSON and the password
+// NOTE: this exposes the validator key to anyone with the output, so it must only be used for deliberate exports
+func (w *Wallet) ExportValidatorKeystore(pubkey rptypes.ValidatorPubkey) ([]byte, string, error) {
+
+	// Get the key and its derivation path
+	key, derivationPath, err := w.LoadValidatorKey(pubkey)
+	if err != nil {
+		return nil, "", err
+	}
+	if key == nil {
+		return nil, "", fmt.Errorf("Validator %s key not found", pubkey.Hex())
+	}
+
+	// Encrypt it with a new password
+	password, err := keystore.GenerateRandomPassword()
+	if err != nil {
+		return nil, "", fmt.Errorf("Could not generate random password: %w", err)
+	}
+	encryptor := eth2ks.New(eth2ks.WithCipher("scrypt"))
+	encryptedKey, err := encryptor.Encrypt(key.Marshal(), password)
+	if err != nil {
+		return nil, "", fmt.Errorf("Could not encrypt validator key: %w", err)
+	}
+
+	// Encode the keystore
+	keystoreBytes, err := json.Marshal(struct {
+		Crypto  map[string]interface{}  `json:"crypto"`
+		Version uint                    `json:"version"`
+		UUID    uuid.UUID               `json:"uuid"`
+		Path    string                  `json:"path"`
+		Pubkey  rptypes.ValidatorPubkey `json:"pubkey"`
+	}{
+		Crypto:  encryptedKey,
+		Version: encryptor.Version(),
+		UUID:    uuid.New(),
+		Path:    derivationPath,
+		Pubkey:  pubkey,
+	})
+	if err != nil {
+		return nil, "", fmt.Errorf("Could not encode validator keystore: %w", err)
+	}
+
+	return keystoreBytes, password, nil
+
+}
+
 // Get a validator private key by index
 func (w *Wallet) getValidatorPrivateKey(index uint) (*eth2types.BLSPrivateKey, string, error) {
 
@@ -8,12 +8,14 @@ import (
 	"io/ioutil"
 	"math/big"
 	"os"
+	"sync"
 
 	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcutil/hdkeychain"
 	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 	"github.com/google/uuid"
 	"github.com/tyler-smith/go-bip39"
 	eth2types "github.com/wealdtech/go-eth2-types/v2"
@@ -26,6 +28,7 @@ import (
 // Config
 const (
 	EntropyBits              = 256
+	ShortEntropyBits         = 128
 	FileMode                 = 0600
 	DefaultNodeKeyPath       = "m/44'/60'/0'/0/%d"
 	LedgerLiveNodeKeyPath    = "m/44'/60'/%d/0/0"
@@ -63,6 +66,11 @@ type Wallet struct {
 	maxFee         *big.Int
 	maxPriorityFee *big.Int
 	gasLimit       uint64
+
+	// Optional check of the configured chain ID against the live client
+	getLiveChainID  func() (*big.Int, error)
+	chainIDVerified bool
+	chainIDLock     sync.Mutex
 }
 
 // Encrypted wallet store
@@ -109,11 +117,25 @@ func (w *Wallet) GetChainID() *big.Int {
 	return copy
 }
 
+// Set a function that gets the chain ID of the connected Execution client.
+// When set, transactions are only signed once the wallet's chain ID has been confirmed to match it.
+func (w *Wallet) SetLiveChainIDGetter(getLiveChainID func() (*big.Int, error)) {
+	w.chainIDLock.Lock()
+	defer w.chainIDLock.Unlock()
+	w.getLiveChainID = getLiveChainID
+	w.chainIDVerified = false
+}
+
 // Add a keystore to the wallet
 func (w *Wallet) AddKeystore(name string, ks keystore.Keystore) {
 	w.keystores[name] = ks
 }
 
+// Get the path the wallet file is stored at
+func (w *Wallet) GetWalletPath() string {
+	return w.walletPath
+}
+
 // Check if the wallet has been initialized
 func (w *Wallet) IsInitialized() bool {
 	return (w.ws != nil && w.seed != nil && w.mk != nil)
@@ -146,16 +168,21 @@ func (w *Wallet) String() (string, error) {
 
 }
 
-// Initialize the wallet from a random seed
-func (w *Wallet) Initialize(derivationPath string, walletIndex uint) (string, error) {
+// Initialize the wallet from a random seed, using a 24-word (256-bit) or 12-word (128-bit) mnemonic
+func (w *Wallet) Initialize(derivationPath string, walletIndex uint, entropyBits int, passphrase string) (string, error) {
 
 	// Check wallet is not initialized
 	if w.IsInitialized() {
 		return "", errors.New("Wallet is already initialized")
 	}
 
+	// Check the mnemonic strength
+	if entropyBits != EntropyBits && entropyBits != ShortEntropyBits {
+		return "", fmt.Errorf("Invalid mnemonic entropy size %d; must be %d or %d bits", entropyBits, ShortEntropyBits, EntropyBits)
+	}
+
 	// Generate mnemonic entropy
-	entropy, err := bip39.NewEntropy(EntropyBits)
+	entropy, err := bip39.NewEntropy(entropyBits)
 	if err != nil {
 		return "", fmt.Errorf("Could not generate wallet mnemonic entropy bytes: %w", err)
 	}
@@ -167,7 +194,7 @@ func (w *Wallet) Initialize(derivationPath string, walletIndex uint) (string, er
 	}
 
 	// Initialize wallet store
-	if err := w.initializeStore(derivationPath, walletIndex, mnemonic); err != nil {
+	if err := w.initializeStore(derivationPath, walletIndex, mnemonic, passphrase); err != nil {
 		return "", err
 	}
 
@@ -176,8 +203,8 @@ func (w *Wallet) Initialize(derivationPath string, walletIndex uint) (string, er
 
 }
 
-// Recover a wallet from a mnemonic
-func (w *Wallet) Recover(derivationPath string, walletIndex uint, mnemonic string) error {
+// Recover a wallet from a mnemonic and its optional BIP-39 passphrase
+func (w *Wallet) Recover(derivationPath string, walletIndex uint, mnemonic string, passphrase string) error {
 
 	// Check wallet is not initialized
 	if w.IsInitialized() {
@@ -190,7 +217,7 @@ func (w *Wallet) Recover(derivationPath string, walletIndex uint, mnemonic strin
 	}
 
 	// Initialize wallet store
-	if err := w.initializeStore(derivationPath, walletIndex, mnemonic); err != nil {
+	if err := w.initializeStore(derivationPath, walletIndex, mnemonic, passphrase); err != nil {
 		return err
 	}
 
@@ -200,7 +227,7 @@ func (w *Wallet) Recover(derivationPath string, walletIndex uint, mnemonic strin
 }
 
 // Recover a wallet from a mnemonic - only used for testing mnemonics
-func (w *Wallet) TestRecovery(derivationPath string, walletIndex uint, mnemonic string) error {
+func (w *Wallet) TestRecovery(derivationPath string, walletIndex uint, mnemonic string, passphrase string) error {
 
 	// Check mnemonic
 	if !bip39.IsMnemonicValid(mnemonic) {
@@ -208,7 +235,7 @@ func (w *Wallet) TestRecovery(derivationPath string, walletIndex uint, mnemonic
 	}
 
 	// Generate seed
-	w.seed = bip39.NewSeed(mnemonic, "")
+	w.seed = bip39.NewSeed(mnemonic, passphrase)
 
 	// Create master key
 	var err error
@@ -275,6 +302,11 @@ func (w *Wallet) Delete() error {
 
 // Signs a serialized TX using the wallet's private key
 func (w *Wallet) Sign(serializedTx []byte) ([]byte, error) {
+	// Make sure the TX will be valid on the connected chain
+	if err := w.verifyChainID(); err != nil {
+		return nil, err
+	}
+
 	// Get private key
 	privateKey, _, err := w.getNodePrivateKey()
 	if err != nil {
@@ -301,6 +333,26 @@ func (w *Wallet) Sign(serializedTx []byte) ([]byte, error) {
 	return signedData, nil
 }
 
+// Check that the wallet's chain ID matches the connected Execution client's, if a check has been set up.
+// A successful check is cached for the life of the wallet.
+func (w *Wallet) verifyChainID() error {
+	w.chainIDLock.Lock()
+	defer w.chainIDLock.Unlock()
+
+	if w.getLiveChainID == nil || w.chainIDVerified {
+		return nil
+	}
+	liveChainID, err := w.getLiveChainID()
+	if err != nil {
+		return fmt.Errorf("Error getting the chain ID of the Execution client: %w", err)
+	}
+	if liveChainID.Cmp(w.chainID) != 0 {
+		return fmt.Errorf("Chain ID mismatch: the Smartnode is configured for chain %s but the Execution client is connected to chain %s; please check that your Execution client is on the same network as your Smartnode configuration", w.chainID.String(), liveChainID.String())
+	}
+	w.chainIDVerified = true
+	return nil
+}
+
 // Signs an arbitrary message using the wallet's private key
 func (w *Wallet) SignMessage(message string) ([]byte, error) {
 	// Get the wallet's private key
@@ -308,7 +360,67 @@ func (w *Wallet) SignMessage(message string) ([]byte, error) {
 	if err != nil {
 		return nil, err
 	}
+	return signMessage(privateKey, message)
+}
+
+// Signs a list of arbitrary messages using the wallet's private key, returning the signatures in the same order
+func (w *Wallet) SignMessages(messages []string) ([][]byte, error) {
+	// Get the wallet's private key
+	privateKey, _, err := w.getNodePrivateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	signedMessages := make([][]byte, len(messages))
+	for i, message := range messages {
+		signedMessages[i], err = signMessage(privateKey, message)
+		if err != nil {
+			return nil, fmt.Errorf("Error signing message %d: %w", i, err)
+		}
+	}
+	return signedMessages, nil
+}
+
+// Signs EIP-712 typed data (e.g. Snapshot votes or permits) using the wallet's private key
+func (w *Wallet) SignTypedData(typedData apitypes.TypedData) ([]byte, error) {
+	// Get the wallet's private key
+	privateKey, _, err := w.getNodePrivateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the digest to sign
+	hash, err := getTypedDataHash(typedData)
+	if err != nil {
+		return nil, err
+	}
+
+	signedData, err := crypto.Sign(hash, privateKey)
+	if err != nil {
+		return nil, fmt.Errorf("Error signing typed data: %w", err)
+	}
+
+	// fix the ECDSA 'v' the same way as for personal_sign messages
+	signedData[crypto.RecoveryIDOffset] += 27
+	return signedData, nil
+}
+
+// Get the EIP-712 digest of typed data: the domain and message hashes, combined with the EIP-712 prefix
+func getTypedDataHash(typedData apitypes.TypedData) ([]byte, error) {
+	domainSeparator, err := typedData.HashStruct("EIP712Domain", typedData.Domain.Map())
+	if err != nil {
+		return nil, fmt.Errorf("Error hashing typed data domain: %w", err)
+	}
+	messageHash, err := typedData.HashStruct(typedData.PrimaryType, typedData.Message)
+	if err != nil {
+		return nil, fmt.Errorf("Error hashing typed data message: %w", err)
+	}
+	rawData := []byte(fmt.Sprintf("\x19\x01%s%s", string(domainSeparator), string(messageHash)))
+	return crypto.Keccak256(rawData), nil
+}
 
+// Signs a message with the personal_sign scheme
+func signMessage(privateKey *ecdsa.PrivateKey, message string) ([]byte, error) {
 	messageHash := accounts.TextHash([]byte(message))
 	signedMessage, err := crypto.Sign(messageHash, privateKey)
 	if err != nil {
@@ -369,11 +481,11 @@ func (w *Wallet) loadStore() (bool, error) {
 
 }
 
-// Initialize the encrypted wallet store from a mnemonic
-func (w *Wallet) initializeStore(derivationPath string, walletIndex uint, mnemonic string) error {
+// Initialize the encrypted wallet store from a mnemonic; only the resulting seed is stored, never the passphrase
+func (w *Wallet) initializeStore(derivationPath string, walletIndex uint, mnemonic string, passphrase string) error {
 
 	// Generate seed
-	w.seed = bip39.NewSeed(mnemonic, "")
+	w.seed = bip39.NewSeed(mnemonic, passphrase)
 
 	// Create master key
 	var err error
@@ -0,0 +1,185 @@
+package wallet
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	rptypes "github.com/rocket-pool/rocketpool-go/types"
+	eth2types "github.com/wealdtech/go-eth2-types/v2"
+	eth2ks "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+
+	"github.com/rocket-pool/smartnode/shared/services/passwords"
+	"github.com/rocket-pool/smartnode/shared/services/wallet/keystore/lighthouse"
+)
+
+// The standard test mnemonic, and the address it derives at the default node key path
+const (
+	testMnemonic    = "test test test test test test test test test test test junk"
+	testNodeAddress = "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266"
+)
+
+// The example typed data from EIP-712, and its digest as given in the EIP
+var (
+	testTypedData = apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": {
+				{Name: "name", Type: "string"},
+				{Name: "version", Type: "string"},
+				{Name: "chainId", Type: "uint256"},
+				{Name: "verifyingContract", Type: "address"},
+			},
+			"Person": {
+				{Name: "name", Type: "string"},
+				{Name: "wallet", Type: "address"},
+			},
+			"Mail": {
+				{Name: "from", Type: "Person"},
+				{Name: "to", Type: "Person"},
+				{Name: "contents", Type: "string"},
+			},
+		},
+		PrimaryType: "Mail",
+		Domain: apitypes.TypedDataDomain{
+			Name:              "Ether Mail",
+			Version:           "1",
+			ChainId:           math.NewHexOrDecimal256(1),
+			VerifyingContract: "0xCcCCccccCCCCcCCCCCCcCcCccCcCCCcCcccccccC",
+		},
+		Message: apitypes.TypedDataMessage{
+			"from": map[string]interface{}{
+				"name":   "Cow",
+				"wallet": "0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826",
+			},
+			"to": map[string]interface{}{
+				"name":   "Bob",
+				"wallet": "0xbBbBBBBbbBBBbbbBbbBbbbbBBbBbbbbBbBbbBBbB",
+			},
+			"contents": "Hello, Bob!",
+		},
+	}
+	testTypedDataHash = "0xbe609aee343fb3c4b28e1df9e632fca64fcfaede20f02e86244efddf30957bd2"
+)
+
+// Create a wallet recovered from the test mnemonic in the given folder
+func newTestWallet(t *testing.T, dir string) *Wallet {
+	pm := passwords.NewPasswordManager(filepath.Join(dir, "password"))
+	w, err := NewWallet(filepath.Join(dir, "wallet"), 1, nil, nil, 0, pm)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Recover(DefaultNodeKeyPath, 0, testMnemonic, ""); err != nil {
+		t.Fatal(err)
+	}
+	return w
+}
+
+func TestGetTypedDataHash(t *testing.T) {
+	hash, err := getTypedDataHash(testTypedData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hexutil.Encode(hash) != testTypedDataHash {
+		t.Errorf("Incorrect typed data hash: expected %s, got %s", testTypedDataHash, hexutil.Encode(hash))
+	}
+}
+
+func TestSignTypedData(t *testing.T) {
+	dir, err := ioutil.TempDir("", "rp-wallet-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	w := newTestWallet(t, dir)
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if nodeAccount.Address != common.HexToAddress(testNodeAddress) {
+		t.Fatalf("Incorrect node address: expected %s, got %s", testNodeAddress, nodeAccount.Address.Hex())
+	}
+
+	signature, err := w.SignTypedData(testTypedData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(signature) != crypto.SignatureLength {
+		t.Fatalf("Incorrect signature length: expected %d, got %d", crypto.SignatureLength, len(signature))
+	}
+	if v := signature[crypto.RecoveryIDOffset]; v != 27 && v != 28 {
+		t.Fatalf("Incorrect signature recovery ID: expected 27 or 28, got %d", v)
+	}
+
+	// Recover the signer from the EIP-712 digest
+	hash, err := getTypedDataHash(testTypedData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig := make([]byte, len(signature))
+	copy(sig, signature)
+	sig[crypto.RecoveryIDOffset] -= 27
+	pubkey, err := crypto.SigToPub(hash, sig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer := crypto.PubkeyToAddress(*pubkey)
+	if !bytes.Equal(signer.Bytes(), nodeAccount.Address.Bytes()) {
+		t.Errorf("Incorrect signer: expected %s, got %s", nodeAccount.Address.Hex(), signer.Hex())
+	}
+}
+
+func TestExportImportedValidatorKeystore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "rp-wallet-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	w := newTestWallet(t, dir)
+	w.AddKeystore("lighthouse", lighthouse.NewKeystore(filepath.Join(dir, "validators"), nil))
+
+	// Import a key that wasn't derived from the wallet's mnemonic
+	if err := eth2types.InitBLS(); err != nil {
+		t.Fatal(err)
+	}
+	key, err := eth2types.GenerateBLSPrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	pubkey := rptypes.BytesToValidatorPubkey(key.PublicKey().Marshal())
+	if err := w.StoreValidatorKey(key, ""); err != nil {
+		t.Fatal(err)
+	}
+
+	// Export it and make sure the keystore decrypts to the imported key
+	keystoreBytes, password, err := w.ExportValidatorKeystore(pubkey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var keystore struct {
+		Crypto map[string]interface{}  `json:"crypto"`
+		Pubkey rptypes.ValidatorPubkey `json:"pubkey"`
+	}
+	if err := json.Unmarshal(keystoreBytes, &keystore); err != nil {
+		t.Fatal(err)
+	}
+	if keystore.Pubkey != pubkey {
+		t.Fatalf("Incorrect keystore pubkey: expected %s, got %s", pubkey.Hex(), keystore.Pubkey.Hex())
+	}
+	decryptedKey, err := eth2ks.New().Decrypt(keystore.Crypto, password)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(decryptedKey, key.Marshal()) {
+		t.Errorf("Exported keystore does not contain the imported key")
+	}
+}
@@ -4,3 +4,10 @@ type APIResponse struct {
 	Status string `json:"status"`
 	Error  string `json:"error"`
 }
+
+// The API routes that can read their state from the latest finalized block with the --finalized flag; all others reject it
+var FinalizedStateRoutes = map[string]bool{
+	"minipool status":               true,
+	"minipool get-finalize-details": true,
+	"node get-rewards-info":         true,
+}
@@ -13,10 +13,11 @@ import (
 )
 
 type MinipoolStatusResponse struct {
-	Status         string            `json:"status"`
-	Error          string            `json:"error"`
-	Minipools      []MinipoolDetails `json:"minipools"`
-	LatestDelegate common.Address    `json:"latestDelegate"`
+	Status               string            `json:"status"`
+	Error                string            `json:"error"`
+	Minipools            []MinipoolDetails `json:"minipools"`
+	LatestDelegate       common.Address    `json:"latestDelegate"`
+	HasSlashedValidators bool              `json:"hasSlashedValidators"`
 }
 type MinipoolDetails struct {
 	Address             common.Address         `json:"address"`
@@ -37,12 +38,19 @@ type MinipoolDetails struct {
 	Delegate            common.Address         `json:"delegate"`
 	PreviousDelegate    common.Address         `json:"previousDelegate"`
 	EffectiveDelegate   common.Address         `json:"effectiveDelegate"`
+	NeedsUpgrade        bool                   `json:"needsUpgrade"`
 	TimeUntilDissolve   time.Duration          `json:"timeUntilDissolve"`
 	Penalties           uint64                 `json:"penalties"`
+	PenaltyThresholdMet bool                   `json:"penaltyThresholdMet"`
+	InScrubPeriod       bool                   `json:"inScrubPeriod"`
+	TimeUntilScrubEnd   time.Duration          `json:"timeUntilScrubEnd"`
+	ScrubVotes          uint64                 `json:"scrubVotes"`
+	ScrubThreshold      uint64                 `json:"scrubThreshold"`
 }
 type ValidatorDetails struct {
 	Exists      bool     `json:"exists"`
 	Active      bool     `json:"active"`
+	Slashed     bool     `json:"slashed"`
 	Index       uint64   `json:"index"`
 	Balance     *big.Int `json:"balance"`
 	NodeBalance *big.Int `json:"nodeBalance"`
@@ -85,6 +93,32 @@ type ExitMinipoolResponse struct {
 	Error  string `json:"error"`
 }
 
+type GetMinipoolExitMessagesResponse struct {
+	Status           string                  `json:"status"`
+	Error            string                  `json:"error"`
+	Epoch            uint64                  `json:"epoch"`
+	ForkVersion      string                  `json:"forkVersion"`
+	Warning          string                  `json:"warning"`
+	ExitMessages     []MinipoolExitMessage   `json:"exitMessages"`
+	NotOnBeaconChain []types.ValidatorPubkey `json:"notOnBeaconChain"`
+	KeyNotFound      []types.ValidatorPubkey `json:"keyNotFound"`
+}
+
+// A validator's signed voluntary exit, keyed by its pubkey
+type MinipoolExitMessage struct {
+	Pubkey        types.ValidatorPubkey `json:"pubkey"`
+	SignedMessage SignedVoluntaryExit   `json:"signedMessage"`
+}
+
+// A signed voluntary exit in the Beacon API's format, so it can be submitted to /eth/v1/beacon/pool/voluntary_exits as-is
+type SignedVoluntaryExit struct {
+	Message struct {
+		Epoch          string `json:"epoch"`
+		ValidatorIndex string `json:"validator_index"`
+	} `json:"message"`
+	Signature string `json:"signature"`
+}
+
 type CanProcessWithdrawalResponse struct {
 	Status        string             `json:"status"`
 	Error         string             `json:"error"`
@@ -170,6 +204,28 @@ type SetUseLatestDelegateResponse struct {
 	Error  string      `json:"error"`
 	TxHash common.Hash `json:"txHash"`
 }
+type CanSetUseLatestDelegateBulkResponse struct {
+	Status    string                                  `json:"status"`
+	Error     string                                  `json:"error"`
+	Minipools []MinipoolCanSetUseLatestDelegateResult `json:"minipools"`
+	GasInfo   rocketpool.GasInfo                      `json:"gasInfo"`
+}
+type MinipoolCanSetUseLatestDelegateResult struct {
+	Address    common.Address     `json:"address"`
+	AlreadySet bool               `json:"alreadySet"`
+	GasInfo    rocketpool.GasInfo `json:"gasInfo"`
+}
+type SetUseLatestDelegateBulkResponse struct {
+	Status    string                               `json:"status"`
+	Error     string                               `json:"error"`
+	Minipools []MinipoolSetUseLatestDelegateResult `json:"minipools"`
+}
+type MinipoolSetUseLatestDelegateResult struct {
+	Address    common.Address `json:"address"`
+	AlreadySet bool           `json:"alreadySet"`
+	TxHash     common.Hash    `json:"txHash"`
+	Error      string         `json:"error"`
+}
 
 type CanStakeMinipoolResponse struct {
 	Status   string             `json:"status"`
@@ -214,3 +270,84 @@ type GetVanityArtifactsResponse struct {
 	MinipoolFactoryAddress common.Address `json:"minipoolFactoryAddress"`
 	InitHash               common.Hash    `json:"initHash"`
 }
+
+type GetMinipoolFinaliseDetailsResponse struct {
+	Status  string                    `json:"status"`
+	Error   string                    `json:"error"`
+	Details []MinipoolFinaliseDetails `json:"details"`
+}
+type MinipoolFinaliseDetails struct {
+	Address               common.Address       `json:"address"`
+	MinipoolStatus        types.MinipoolStatus `json:"minipoolStatus"`
+	Balance               *big.Int             `json:"balance"`
+	NodeShare             *big.Int             `json:"nodeShare"`
+	ValidatorExited       bool                 `json:"validatorExited"`
+	ValidatorWithdrawable bool                 `json:"validatorWithdrawable"`
+	Finalised             bool                 `json:"finalised"`
+	InvalidStatus         bool                 `json:"invalidStatus"`
+	ValidatorNotExited    bool                 `json:"validatorNotExited"`
+	CanFinalise           bool                 `json:"canFinalise"`
+}
+
+type GetMinipoolBalanceSplitsResponse struct {
+	Status string                 `json:"status"`
+	Error  string                 `json:"error"`
+	Splits []MinipoolBalanceSplit `json:"splits"`
+}
+type MinipoolBalanceSplit struct {
+	Address            common.Address `json:"address"`
+	NodeFee            float64        `json:"nodeFee"`
+	NodeDepositBalance *big.Int       `json:"nodeDepositBalance"`
+	Balance            *big.Int       `json:"balance"`
+	EffectiveBalance   *big.Int       `json:"effectiveBalance"`
+	NodeShare          *big.Int       `json:"nodeShare"`
+	RethShare          *big.Int       `json:"rethShare"`
+	NodeApr            float64        `json:"nodeApr"`
+}
+
+type GetMinipoolBeaconWithdrawalsResponse struct {
+	Status      string                                       `json:"status"`
+	Error       string                                       `json:"error"`
+	StartSlot   uint64                                       `json:"startSlot"`
+	EndSlot     uint64                                       `json:"endSlot"`
+	Withdrawals map[common.Address]MinipoolBeaconWithdrawals `json:"withdrawals"`
+}
+type MinipoolBeaconWithdrawals struct {
+	ValidatorIndex uint64 `json:"validatorIndex"`
+	TotalGwei      uint64 `json:"totalGwei"`
+	Count          uint64 `json:"count"`
+}
+
+type ExportMinipoolDepositDataResponse struct {
+	Status      string               `json:"status"`
+	Error       string               `json:"error"`
+	DepositData []StakingDepositData `json:"depositData"`
+}
+type StakingDepositData struct {
+	Pubkey                string `json:"pubkey"`
+	WithdrawalCredentials string `json:"withdrawal_credentials"`
+	Amount                uint64 `json:"amount"`
+	Signature             string `json:"signature"`
+	DepositMessageRoot    string `json:"deposit_message_root"`
+	DepositDataRoot       string `json:"deposit_data_root"`
+	ForkVersion           string `json:"fork_version"`
+	NetworkName           string `json:"network_name"`
+}
+
+type ImportMinipoolKeyResponse struct {
+	Status string                `json:"status"`
+	Error  string                `json:"error"`
+	Pubkey types.ValidatorPubkey `json:"pubkey"`
+}
+
+type GetMinipoolQueuePositionResponse struct {
+	Status             string               `json:"status"`
+	Error              string               `json:"error"`
+	MinipoolStatus     types.MinipoolStatus `json:"minipoolStatus"`
+	InvalidStatus      bool                 `json:"invalidStatus"`
+	NotQueued          bool                 `json:"notQueued"`
+	Position           int64                `json:"position"`
+	QueueLength        uint64               `json:"queueLength"`
+	QueueCapacity      *big.Int             `json:"queueCapacity"`
+	DepositPoolBalance *big.Int             `json:"depositPoolBalance"`
+}
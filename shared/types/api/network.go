@@ -24,6 +24,34 @@ type RplPriceResponse struct {
 	MaxPerMinipoolRplStake *big.Int `json:"maxPerMinipoolRplStake"`
 }
 
+type DownloadRewardsFilesResponse struct {
+	Status     string   `json:"status"`
+	Error      string   `json:"error"`
+	Downloaded []uint64 `json:"downloaded"`
+	Skipped    []uint64 `json:"skipped"`
+}
+
+type EffectiveRplAtBlockResponse struct {
+	Status                 string   `json:"status"`
+	Error                  string   `json:"error"`
+	BlockNumber            uint64   `json:"blockNumber"`
+	EffectiveRplStake      *big.Int `json:"effectiveRplStake"`
+	EffectiveRplStakeRpl   float64  `json:"effectiveRplStakeRpl"`
+	EffectiveRplStakeValue *big.Int `json:"effectiveRplStakeValue"`
+	EffectiveRplStakeEth   float64  `json:"effectiveRplStakeEth"`
+	RplPrice               *big.Int `json:"rplPrice"`
+	RplPriceEth            float64  `json:"rplPriceEth"`
+}
+
+type OracleRplPriceResponse struct {
+	Status          string         `json:"status"`
+	Error           string         `json:"error"`
+	RplPrice        *big.Int       `json:"rplPrice"`
+	BlockNumber     uint64         `json:"blockNumber"`
+	OracleAddress   common.Address `json:"oracleAddress"`
+	RplTokenAddress common.Address `json:"rplTokenAddress"`
+}
+
 type NetworkStatsResponse struct {
 	Status                    string         `json:"status"`
 	Error                     string         `json:"error"`
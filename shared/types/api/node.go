@@ -60,6 +60,9 @@ type CanRegisterNodeResponse struct {
 	CanRegister          bool               `json:"canRegister"`
 	AlreadyRegistered    bool               `json:"alreadyRegistered"`
 	RegistrationDisabled bool               `json:"registrationDisabled"`
+	InsufficientBalance  bool               `json:"insufficientBalance"`
+	NodeAddress          common.Address     `json:"nodeAddress"`
+	NodeBalance          *big.Int           `json:"nodeBalance"`
 	GasInfo              rocketpool.GasInfo `json:"gasInfo"`
 }
 type RegisterNodeResponse struct {
@@ -216,11 +219,16 @@ type CanNodeSendResponse struct {
 	Error               string             `json:"error"`
 	CanSend             bool               `json:"canSend"`
 	InsufficientBalance bool               `json:"insufficientBalance"`
+	Amount              *big.Int           `json:"amount"`
+	TokenName           string             `json:"tokenName"`
+	TokenSymbol         string             `json:"tokenSymbol"`
+	TokenDecimals       uint8              `json:"tokenDecimals"`
 	GasInfo             rocketpool.GasInfo `json:"gasInfo"`
 }
 type NodeSendResponse struct {
 	Status string      `json:"status"`
 	Error  string      `json:"error"`
+	Amount *big.Int    `json:"amount"`
 	TxHash common.Hash `json:"txHash"`
 }
 
@@ -280,6 +288,29 @@ type NodeRewardsResponse struct {
 	TxHash                      common.Hash   `json:"txHash"`
 }
 
+type NodeEstimateRewardsResponse struct {
+	Status            string    `json:"status"`
+	Error             string    `json:"error"`
+	Index             uint64    `json:"index"`
+	IntervalStartTime time.Time `json:"intervalStartTime"`
+	SnapshotTime      time.Time `json:"snapshotTime"`
+	ConsensusBlock    uint64    `json:"consensusBlock"`
+	ExecutionBlock    uint64    `json:"executionBlock"`
+	Trusted           bool      `json:"trusted"`
+	CollateralRpl     *big.Int  `json:"collateralRpl"`
+	OracleDaoRpl      *big.Int  `json:"oracleDaoRpl"`
+	SmoothingPoolEth  *big.Int  `json:"smoothingPoolEth"`
+}
+
+type ValidateDepositDataResponse struct {
+	Status             string      `json:"status"`
+	Error              string      `json:"error"`
+	Valid              bool        `json:"valid"`
+	ValidationError    string      `json:"validationError"`
+	DepositDataRoot    common.Hash `json:"depositDataRoot"`
+	GenesisForkVersion string      `json:"genesisForkVersion"`
+}
+
 type DepositContractInfoResponse struct {
 	Status                string         `json:"status"`
 	Error                 string         `json:"error"`
@@ -296,6 +327,18 @@ type NodeSignResponse struct {
 	SignedData string `json:"signedData"`
 }
 
+type NodeSignMessagesResponse struct {
+	Status     string   `json:"status"`
+	Error      string   `json:"error"`
+	SignedData []string `json:"signedData"`
+}
+
+type NodeVerifySignedMessageResponse struct {
+	Status string `json:"status"`
+	Error  string `json:"error"`
+	Valid  bool   `json:"valid"`
+}
+
 type EstimateSetSnapshotDelegateGasResponse struct {
 	Status  string             `json:"status"`
 	Error   string             `json:"error"`
@@ -341,8 +384,26 @@ type NodeCanDistributeResponse struct {
 	Error          string             `json:"error"`
 	Balance        *big.Int           `json:"balance"`
 	AverageNodeFee float64            `json:"averageNodeFee"`
+	NodeShare      *big.Int           `json:"nodeShare"`
+	UserShare      *big.Int           `json:"userShare"`
+	NodeBalance    *big.Int           `json:"nodeBalance"`
+	GasCost        *big.Int           `json:"gasCost"`
+	BelowReserve   bool               `json:"belowReserve"`
 	GasInfo        rocketpool.GasInfo `json:"gasInfo"`
 }
+type NodeCanWithdrawEthResponse struct {
+	Status               string               `json:"status"`
+	Error                string               `json:"error"`
+	FeeDistributorShare  *big.Int             `json:"feeDistributorShare"`
+	MinipoolRefunds      []NodeMinipoolRefund `json:"minipoolRefunds"`
+	MinipoolRefundsTotal *big.Int             `json:"minipoolRefundsTotal"`
+	UnclaimedRewards     *big.Int             `json:"unclaimedRewards"`
+	TotalWithdrawable    *big.Int             `json:"totalWithdrawable"`
+}
+type NodeMinipoolRefund struct {
+	Address common.Address `json:"address"`
+	Amount  *big.Int       `json:"amount"`
+}
 type NodeDistributeResponse struct {
 	Status string      `json:"status"`
 	Error  string      `json:"error"`
@@ -383,10 +444,13 @@ type NodeClaimAndStakeRewardsResponse struct {
 }
 
 type GetSmoothingPoolRegistrationStatusResponse struct {
-	Status                  string        `json:"status"`
-	Error                   string        `json:"error"`
-	NodeRegistered          bool          `json:"nodeRegistered"`
-	TimeLeftUntilChangeable time.Duration `json:"timeLeftUntilChangeable"`
+	Status                      string        `json:"status"`
+	Error                       string        `json:"error"`
+	NodeRegistered              bool          `json:"nodeRegistered"`
+	RegistrationChangedTime     time.Time     `json:"registrationChangedTime"`
+	RegistrationChangedInterval uint64        `json:"registrationChangedInterval"`
+	ChangeAvailableTime         time.Time     `json:"changeAvailableTime"`
+	TimeLeftUntilChangeable     time.Duration `json:"timeLeftUntilChangeable"`
 }
 type CanSetSmoothingPoolRegistrationStatusResponse struct {
 	Status  string             `json:"status"`
@@ -434,3 +498,36 @@ type SnapshotVotedProposals struct {
 		Votes []SnapshotProposalVote `json:"votes"`
 	} `json:"data"`
 }
+
+type NodeGetCollateralHistoryResponse struct {
+	Status  string                   `json:"status"`
+	Error   string                   `json:"error"`
+	History []NodeCollateralSnapshot `json:"history"`
+}
+type NodeGetRplStakeLimitsResponse struct {
+	Status            string               `json:"status"`
+	Error             string               `json:"error"`
+	RplStake          *big.Int             `json:"rplStake"`
+	EffectiveRplStake *big.Int             `json:"effectiveRplStake"`
+	MinimumRplStake   *big.Int             `json:"minimumRplStake"`
+	MaximumRplStake   *big.Int             `json:"maximumRplStake"`
+	RplPrice          *big.Int             `json:"rplPrice"`
+	ActiveMinipools   uint64               `json:"activeMinipools"`
+	CollateralRatio   float64              `json:"collateralRatio"`
+	Targets           []NodeRplStakeTarget `json:"targets"`
+}
+type NodeRplStakeTarget struct {
+	CollateralPercent float64  `json:"collateralPercent"`
+	RplStake          *big.Int `json:"rplStake"`
+	RplToStake        *big.Int `json:"rplToStake"`
+	RplToWithdraw     *big.Int `json:"rplToWithdraw"`
+}
+type NodeCollateralSnapshot struct {
+	BlockNumber         uint64   `json:"blockNumber"`
+	RplStake            *big.Int `json:"rplStake"`
+	MinimumRplStake     *big.Int `json:"minimumRplStake"`
+	RplPrice            *big.Int `json:"rplPrice"`
+	ActiveMinipools     uint64   `json:"activeMinipools"`
+	CollateralRatio     float64  `json:"collateralRatio"`
+	Undercollateralized bool     `json:"undercollateralized"`
+}
@@ -2,6 +2,7 @@ package api
 
 import (
 	"math/big"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/rocket-pool/rocketpool-go/dao"
@@ -42,9 +43,28 @@ type TNDAOProposalsResponse struct {
 }
 
 type TNDAOProposalResponse struct {
-	Status    string              `json:"status"`
-	Error     string              `json:"error"`
-	Proposals dao.ProposalDetails `json:"proposal"`
+	Status           string                    `json:"status"`
+	Error            string                    `json:"error"`
+	Proposals        dao.ProposalDetails       `json:"proposal"`
+	PayloadFunction  string                    `json:"payloadFunction"`
+	PayloadArguments []ProposalPayloadArgument `json:"payloadArguments"`
+}
+type TNDAOActiveProposalsResponse struct {
+	Status    string                `json:"status"`
+	Error     string                `json:"error"`
+	Proposals []TNDAOActiveProposal `json:"proposals"`
+}
+type TNDAOActiveProposal struct {
+	Proposal           dao.ProposalDetails `json:"proposal"`
+	TimeRemaining      time.Duration       `json:"timeRemaining"`
+	QuorumReached      bool                `json:"quorumReached"`
+	JoinedAfterCreated bool                `json:"joinedAfterCreated"`
+	NeedsVote          bool                `json:"needsVote"`
+}
+type ProposalPayloadArgument struct {
+	Name  string `json:"name"`
+	Type  string `json:"type"`
+	Value string `json:"value"`
 }
 
 type CanProposeTNDAOInviteResponse struct {
@@ -78,12 +98,23 @@ type ProposeTNDAOLeaveResponse struct {
 }
 
 type CanProposeTNDAOReplaceResponse struct {
-	Status                 string             `json:"status"`
-	Error                  string             `json:"error"`
-	CanPropose             bool               `json:"canPropose"`
-	ProposalCooldownActive bool               `json:"proposalCooldownActive"`
-	MemberAlreadyExists    bool               `json:"memberAlreadyExists"`
-	GasInfo                rocketpool.GasInfo `json:"gasInfo"`
+	Status                    string                     `json:"status"`
+	Error                     string                     `json:"error"`
+	CanPropose                bool                       `json:"canPropose"`
+	ProposalCooldownActive    bool                       `json:"proposalCooldownActive"`
+	LeavingMemberDoesNotExist bool                       `json:"leavingMemberDoesNotExist"`
+	MemberAlreadyExists       bool                       `json:"memberAlreadyExists"`
+	InsufficientRplBond       bool                       `json:"insufficientRplBond"`
+	Proposals                 []TNDAOReplacementProposal `json:"proposals"`
+}
+type TNDAOReplacementProposal struct {
+	Type          string             `json:"type"`
+	Message       string             `json:"message"`
+	MemberAddress common.Address     `json:"memberAddress"`
+	MemberId      string             `json:"memberId"`
+	MemberUrl     string             `json:"memberUrl"`
+	FineAmount    *big.Int           `json:"fineAmount"`
+	GasInfo       rocketpool.GasInfo `json:"gasInfo"`
 }
 type ProposeTNDAOReplaceResponse struct {
 	Status     string      `json:"status"`
@@ -97,6 +128,7 @@ type CanProposeTNDAOKickResponse struct {
 	Error                  string             `json:"error"`
 	CanPropose             bool               `json:"canPropose"`
 	ProposalCooldownActive bool               `json:"proposalCooldownActive"`
+	MemberDoesNotExist     bool               `json:"memberDoesNotExist"`
 	InsufficientRplBond    bool               `json:"insufficientRplBond"`
 	GasInfo                rocketpool.GasInfo `json:"gasInfo"`
 }
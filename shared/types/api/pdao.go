@@ -0,0 +1,28 @@
+package api
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/rocketpool-go/rocketpool"
+)
+
+type PDAOBootstrapStatusResponse struct {
+	Status              string         `json:"status"`
+	Error               string         `json:"error"`
+	BootstrapModeActive bool           `json:"bootstrapModeActive"`
+	Guardian            common.Address `json:"guardian"`
+	IsGuardian          bool           `json:"isGuardian"`
+}
+
+type CanPDAOBootstrapSettingResponse struct {
+	Status                string             `json:"status"`
+	Error                 string             `json:"error"`
+	CanBootstrap          bool               `json:"canBootstrap"`
+	BootstrapModeDisabled bool               `json:"bootstrapModeDisabled"`
+	NotGuardian           bool               `json:"notGuardian"`
+	GasInfo               rocketpool.GasInfo `json:"gasInfo"`
+}
+type PDAOBootstrapSettingResponse struct {
+	Status string      `json:"status"`
+	Error  string      `json:"error"`
+	TxHash common.Hash `json:"txHash"`
+}
@@ -24,6 +24,18 @@ type WalletStatusResponse struct {
 	AccountAddress    common.Address `json:"accountAddress"`
 }
 
+type WalletStatusDetailsResponse struct {
+	Status   string               `json:"status"`
+	Error    string               `json:"error"`
+	Wallet   WalletArtifactStatus `json:"wallet"`
+	Password WalletArtifactStatus `json:"password"`
+}
+type WalletArtifactStatus struct {
+	Path      string `json:"path"`
+	Exists    bool   `json:"exists"`
+	ReadError string `json:"readError"`
+}
+
 type SetPasswordResponse struct {
 	Status string `json:"status"`
 	Error  string `json:"error"`
@@ -67,6 +79,21 @@ type ExportWalletResponse struct {
 	AccountPrivateKey string `json:"accountPrivateKey"`
 }
 
+type ExportWalletBackupResponse struct {
+	Status                string `json:"status"`
+	Error                 string `json:"error"`
+	Backup                string `json:"backup"`
+	ValidatorKeyFileCount uint   `json:"validatorKeyFileCount"`
+}
+
+type ImportWalletBackupResponse struct {
+	Status                string `json:"status"`
+	Error                 string `json:"error"`
+	RestoredWallet        bool   `json:"restoredWallet"`
+	RestoredPassword      bool   `json:"restoredPassword"`
+	ValidatorKeyFileCount uint   `json:"validatorKeyFileCount"`
+}
+
 type TestMnemonicResponse struct {
 	Status           string         `json:"status"`
 	Error            string         `json:"error"`
@@ -78,3 +105,33 @@ type PurgeResponse struct {
 	Status string `json:"status"`
 	Error  string `json:"error"`
 }
+
+type WalletEnsProfileResponse struct {
+	Status      string            `json:"status"`
+	Error       string            `json:"error"`
+	Address     common.Address    `json:"address"`
+	HasName     bool              `json:"hasName"`
+	Name        string            `json:"name"`
+	TextRecords map[string]string `json:"textRecords"`
+}
+
+type PeekValidatorKeysResponse struct {
+	Status string               `json:"status"`
+	Error  string               `json:"error"`
+	Keys   []PeekedValidatorKey `json:"keys"`
+}
+type PeekedValidatorKey struct {
+	Index  uint                  `json:"index"`
+	Pubkey types.ValidatorPubkey `json:"pubkey"`
+}
+
+type ExportValidatorKeysResponse struct {
+	Status string                 `json:"status"`
+	Error  string                 `json:"error"`
+	Keys   []ExportedValidatorKey `json:"keys"`
+}
+type ExportedValidatorKey struct {
+	Pubkey   types.ValidatorPubkey `json:"pubkey"`
+	Keystore string                `json:"keystore"`
+	Password string                `json:"password"`
+}
@@ -8,6 +8,7 @@ type ExecutionClient string
 type ConsensusClient string
 type RewardsMode string
 type MevRelay string
+type TreeUploadService string
 
 // Enum to describe which container(s) a parameter impacts, so the Smartnode knows which
 // ones to restart upon a settings change
@@ -33,6 +34,7 @@ const (
 	Network_Prater  Network = "prater"
 	Network_Kiln    Network = "kiln"
 	Network_Ropsten Network = "ropsten"
+	Network_Custom  Network = "custom"
 )
 
 // Enum to describe the mode for a client - local (Docker Mode) or external (Hybrid Mode)
@@ -81,6 +83,12 @@ const (
 	RewardsMode_Generate RewardsMode = "generate"
 )
 
+// Enum to describe the services that can be used to upload rewards trees
+const (
+	TreeUploadService_Web3Storage TreeUploadService = "web3Storage"
+	TreeUploadService_Ipfs        TreeUploadService = "ipfs"
+)
+
 // Enum to describe MEV-boost relays
 const (
 	MevRelay_Unknown            MevRelay = ""
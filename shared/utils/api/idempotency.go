@@ -0,0 +1,147 @@
+package api
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// The amount of time a response built for an idempotency key can be replayed
+const IdempotencyKeyTTL time.Duration = 10 * time.Minute
+
+// How long to wait for another process to finish updating the cache file
+const idempotencyCacheLockTimeout time.Duration = 5 * time.Second
+
+// A response that was previously built for an idempotency key
+type idempotencyRecord struct {
+	Time     time.Time       `json:"time"`
+	Response json.RawMessage `json:"response"`
+}
+
+// A file-backed cache of responses built for idempotency keys.
+// The API runs as a new process for each call, so the cache has to live on disk to survive client retries.
+type IdempotencyCache struct {
+	path string
+}
+
+// Create a new idempotency cache backed by the file at the given path
+func NewIdempotencyCache(path string) *IdempotencyCache {
+	return &IdempotencyCache{
+		path: path,
+	}
+}
+
+// Get the response previously built for the given key, if it hasn't expired yet
+func (ic *IdempotencyCache) Get(key string) ([]byte, bool, error) {
+	records, err := ic.load()
+	if err != nil {
+		return nil, false, err
+	}
+	record, exists := records[key]
+	if !exists || time.Since(record.Time) > IdempotencyKeyTTL {
+		return nil, false, nil
+	}
+	return record.Response, true, nil
+}
+
+// Claim the given key for this process, so a concurrent call with the same key can't build a second transaction.
+// The returned function releases the key; it must be called once the call's response has been stored.
+func (ic *IdempotencyCache) Lock(key string) (func(), error) {
+	keyHash := sha256.Sum256([]byte(key))
+	lockPath := fmt.Sprintf("%s.%s.lock", ic.path, hex.EncodeToString(keyHash[:]))
+	unlock, err := lockFile(lockPath, 0)
+	if errors.Is(err, os.ErrExist) {
+		return nil, fmt.Errorf("Another API call with the same idempotency key is still running")
+	}
+	return unlock, err
+}
+
+// Store the response built for the given key, pruning any expired records
+func (ic *IdempotencyCache) Set(key string, response []byte) error {
+	// Lock the cache file so concurrent calls with different keys don't overwrite each other's records
+	unlock, err := lockFile(ic.path+".lock", idempotencyCacheLockTimeout)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	records, err := ic.load()
+	if err != nil {
+		return err
+	}
+	for existingKey, record := range records {
+		if time.Since(record.Time) > IdempotencyKeyTTL {
+			delete(records, existingKey)
+		}
+	}
+	records[key] = idempotencyRecord{
+		Time:     time.Now(),
+		Response: json.RawMessage(response),
+	}
+
+	bytes, err := json.Marshal(records)
+	if err != nil {
+		return fmt.Errorf("Could not serialize idempotency cache: %w", err)
+	}
+	err = ioutil.WriteFile(ic.path, bytes, 0600)
+	if err != nil {
+		return fmt.Errorf("Could not write idempotency cache to %s: %w", ic.path, err)
+	}
+	return nil
+}
+
+// Load the cached records from disk
+func (ic *IdempotencyCache) load() (map[string]idempotencyRecord, error) {
+	records := map[string]idempotencyRecord{}
+	bytes, err := ioutil.ReadFile(ic.path)
+	if os.IsNotExist(err) {
+		return records, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Could not read idempotency cache at %s: %w", ic.path, err)
+	}
+	if err := json.Unmarshal(bytes, &records); err != nil {
+		// A corrupted cache shouldn't block transactions, so start over
+		return map[string]idempotencyRecord{}, nil
+	}
+	return records, nil
+}
+
+// Create a lock file exclusively, retrying until the timeout if another process holds it.
+// Lock files older than the idempotency key TTL were left behind by a process that died, so they're removed.
+func lockFile(path string, timeout time.Duration) (func(), error) {
+	err := os.MkdirAll(filepath.Dir(path), 0755)
+	if err != nil {
+		return nil, fmt.Errorf("Could not create idempotency cache folder: %w", err)
+	}
+
+	deadline := time.Now().Add(timeout)
+	for {
+		file, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+		if err == nil {
+			file.Close()
+			return func() {
+				os.Remove(path)
+			}, nil
+		}
+		if !os.IsExist(err) {
+			return nil, fmt.Errorf("Could not create lock file %s: %w", path, err)
+		}
+
+		// Clear stale locks
+		if info, statErr := os.Stat(path); statErr == nil && time.Since(info.ModTime()) > IdempotencyKeyTTL {
+			os.Remove(path)
+			continue
+		}
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("Could not lock %s: %w", path, os.ErrExist)
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+}
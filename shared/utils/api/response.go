@@ -9,6 +9,14 @@ import (
 	"github.com/rocket-pool/smartnode/shared/types/api"
 )
 
+// Optional callback that receives each successful API response after it has been encoded
+var responseRecorder func(responseBytes []byte)
+
+// Set a callback that receives each successful API response after it has been encoded
+func SetResponseRecorder(recorder func(responseBytes []byte)) {
+	responseRecorder = recorder
+}
+
 // Print an API response
 // response must be a pointer to a struct type with Error and Status string fields
 func PrintResponse(response interface{}, responseError error) {
@@ -53,6 +61,11 @@ func PrintResponse(response interface{}, responseError error) {
 		return
 	}
 
+	// Record successful responses
+	if responseRecorder != nil && sf.String() == "success" {
+		responseRecorder(responseBytes)
+	}
+
 	// Print
 	fmt.Println(string(responseBytes))
 
@@ -1,10 +1,12 @@
 package api
 
 import (
+	"context"
 	"fmt"
 	"math/big"
 	"time"
 
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/rocket-pool/rocketpool-go/rocketpool"
 	"github.com/rocket-pool/rocketpool-go/settings/protocol"
@@ -18,6 +20,9 @@ import (
 // The fraction of the timeout period to trigger overdue transactions
 const TimeoutSafetyFactor int = 2
 
+// How often to check the chain head while waiting for confirmation blocks
+const confirmationPollInterval = 6 * time.Second
+
 // Print the gas price and cost of a TX
 func PrintAndCheckGasInfo(gasInfo rocketpool.GasInfo, checkThreshold bool, gasThresholdGwei float64, logger log.ColorLogger, maxFeeWei *big.Int, gasLimit uint64) bool {
 
@@ -54,6 +59,47 @@ func PrintAndCheckGasInfo(gasInfo rocketpool.GasInfo, checkThreshold bool, gasTh
 	return true
 }
 
+// Check that a TX's worst-case cost won't drop the node wallet's balance below the configured ETH reserve.
+// Returns true if the TX can be submitted.
+func CheckEthReserve(cfg *config.RocketPoolConfig, ec rocketpool.ExecutionClient, opts *bind.TransactOpts, gasInfo rocketpool.GasInfo, maxFeeWei *big.Int, gasLimit uint64, logger log.ColorLogger) (bool, error) {
+
+	// Ignore the check if the reserve is disabled
+	reserveEth := cfg.Smartnode.MinimumEthReserve.Value.(float64)
+	if reserveEth <= 0 {
+		return true, nil
+	}
+	reserveWei := eth.EthToWei(reserveEth)
+
+	// Get the worst-case TX cost
+	var gas *big.Int
+	if gasLimit != 0 {
+		gas = new(big.Int).SetUint64(gasLimit)
+	} else {
+		gas = new(big.Int).SetUint64(gasInfo.SafeGasLimit)
+	}
+	totalCost := new(big.Int).Mul(maxFeeWei, gas)
+	if opts.Value != nil {
+		totalCost.Add(totalCost, opts.Value)
+	}
+
+	// Get the node wallet's balance
+	balance, err := ec.BalanceAt(context.Background(), opts.From, nil)
+	if err != nil {
+		return false, fmt.Errorf("Error getting node wallet balance: %w", err)
+	}
+
+	// Check the remaining balance against the reserve
+	remaining := new(big.Int).Sub(balance, totalCost)
+	if remaining.Cmp(reserveWei) < 0 {
+		logger.Printlnf("This transaction could cost up to %.6f ETH, which would drop the node wallet's balance of %.6f ETH below the minimum reserve of %.6f ETH. "+
+			"Aborting the transaction.", math.RoundDown(eth.WeiToEth(totalCost), 6), math.RoundDown(eth.WeiToEth(balance), 6), reserveEth)
+		return false, nil
+	}
+
+	return true, nil
+
+}
+
 // Print a TX's details to the logger and waits for it to validated.
 func PrintAndWaitForTransaction(cfg *config.RocketPoolConfig, hash common.Hash, ec rocketpool.ExecutionClient, logger log.ColorLogger) error {
 
@@ -68,10 +114,49 @@ func PrintAndWaitForTransaction(cfg *config.RocketPoolConfig, hash common.Hash,
 	logger.Println("Waiting for the transaction to be validated...")
 
 	// Wait for the TX to be included in a block
-	if _, err := utils.WaitForTransaction(ec, hash); err != nil {
+	receipt, err := utils.WaitForTransaction(ec, hash)
+	if err != nil {
 		return fmt.Errorf("Error waiting for transaction: %w", err)
 	}
 
+	// Wait for the configured number of confirmation blocks on top of it
+	confirmations := cfg.Smartnode.TxConfirmationBlocks.Value.(uint64)
+	if confirmations > 0 {
+		logger.Printlnf("Transaction was included in block %d, waiting for %d confirmation blocks...", receipt.BlockNumber.Uint64(), confirmations)
+		if err := waitForConfirmations(ec, hash, receipt.BlockNumber.Uint64(), confirmations); err != nil {
+			return err
+		}
+	}
+
+	return nil
+
+}
+
+// Wait until the chain head is the given number of blocks past the block a TX was included in, and make sure the TX is still included afterwards
+func waitForConfirmations(ec rocketpool.ExecutionClient, hash common.Hash, includedBlock uint64, confirmations uint64) error {
+
+	targetBlock := includedBlock + confirmations
+	for {
+		head, err := ec.BlockNumber(context.Background())
+		if err != nil {
+			return fmt.Errorf("Error getting the latest block while waiting for confirmations: %w", err)
+		}
+		if head >= targetBlock {
+			break
+		}
+		time.Sleep(confirmationPollInterval)
+	}
+
+	// Make sure a reorg didn't remove the TX while we were waiting
+	receipt, err := ec.TransactionReceipt(context.Background(), hash)
+	if err != nil {
+		return fmt.Errorf("Transaction %s is no longer included in the chain after waiting for %d confirmation blocks (it may have been reorged out): %w", hash.Hex(), confirmations, err)
+	}
+	if receipt.BlockNumber.Uint64() != includedBlock {
+		// It was re-included in a later block, so wait for that block to be confirmed as well
+		return waitForConfirmations(ec, hash, receipt.BlockNumber.Uint64(), confirmations)
+	}
+
 	return nil
 
 }
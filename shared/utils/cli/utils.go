@@ -148,6 +148,8 @@ func PrintNetwork(rp *rocketpool.Client) error {
 		fmt.Printf("Your Smartnode is currently using the %sKiln Test Network.%s\n\n", colorYellow, colorReset)
 	case cfgtypes.Network_Ropsten:
 		fmt.Printf("Your Smartnode is currently using the %sRopsten Test Network.%s\n\n", colorYellow, colorReset)
+	case cfgtypes.Network_Custom:
+		fmt.Printf("Your Smartnode is currently using a %sCustom Network%s (chain ID %d).\n\n", colorYellow, colorReset, cfg.Smartnode.GetChainID())
 	default:
 		fmt.Printf("%sYou are on an unexpected network [%v].%s\n\n", colorYellow, currentNetwork, colorReset)
 	}
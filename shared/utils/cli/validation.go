@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	"github.com/ethereum/go-ethereum/common"
+	rptypes "github.com/rocket-pool/rocketpool-go/types"
 	"github.com/tyler-smith/go-bip39"
 	"github.com/urfave/cli"
 
@@ -109,8 +110,8 @@ func ValidatePercentage(name, value string) (float64, error) {
 // Validate a token type
 func ValidateTokenType(name, value string) (string, error) {
 	val := strings.ToLower(value)
-	if !(val == "eth" || val == "rpl" || val == "fsrpl" || val == "reth") {
-		return "", fmt.Errorf("Invalid %s '%s' - valid types are 'ETH', 'RPL', 'fsRPL', and 'rETH'", name, value)
+	if !(val == "eth" || val == "rpl" || val == "fsrpl" || val == "reth" || common.IsHexAddress(val)) {
+		return "", fmt.Errorf("Invalid %s '%s' - valid types are 'ETH', 'RPL', 'fsRPL', 'rETH', or the address of an ERC20 token", name, value)
 	}
 	return val, nil
 }
@@ -265,3 +266,43 @@ func ValidateTxHash(name, value string) (common.Hash, error) {
 	return hash, nil
 
 }
+
+// Validate a validator pubkey
+func ValidatePubkey(name, value string) (rptypes.ValidatorPubkey, error) {
+
+	// Remove a 0x prefix if present
+	value = strings.TrimPrefix(value, "0x")
+
+	// Pubkey should be 96 characters long
+	if len(value) != hex.EncodedLen(rptypes.ValidatorPubkeyLength) {
+		return rptypes.ValidatorPubkey{}, fmt.Errorf("Invalid %s '%s': it must have %d characters.", name, value, hex.EncodedLen(rptypes.ValidatorPubkeyLength))
+	}
+
+	// Try to parse the string
+	bytes, err := hex.DecodeString(value)
+	if err != nil {
+		return rptypes.ValidatorPubkey{}, fmt.Errorf("Invalid %s '%s': %w", name, value, err)
+	}
+	return rptypes.BytesToValidatorPubkey(bytes), nil
+
+}
+
+// Validate a validator signature
+func ValidateValidatorSignature(name, value string) (rptypes.ValidatorSignature, error) {
+
+	// Remove a 0x prefix if present
+	value = strings.TrimPrefix(value, "0x")
+
+	// Signature should be 192 characters long
+	if len(value) != hex.EncodedLen(rptypes.ValidatorSignatureLength) {
+		return rptypes.ValidatorSignature{}, fmt.Errorf("Invalid %s '%s': it must have %d characters.", name, value, hex.EncodedLen(rptypes.ValidatorSignatureLength))
+	}
+
+	// Try to parse the string
+	bytes, err := hex.DecodeString(value)
+	if err != nil {
+		return rptypes.ValidatorSignature{}, fmt.Errorf("Invalid %s '%s': %w", name, value, err)
+	}
+	return rptypes.BytesToValidatorSignature(bytes), nil
+
+}
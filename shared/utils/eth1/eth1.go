@@ -62,7 +62,8 @@ func CheckForNonceOverride(c *cli.Context, opts *bind.TransactOpts) error {
 
 }
 
-// Determines if the primary EC can be used for historical queries, or if the Archive EC is required
+// Determines if the primary EC can be used for historical queries, or if one of the Archive ECs is required
+// The Archive ECs are tried in the order they were configured, and the first one that can serve the block is used
 func GetBestApiClient(primary *rocketpool.RocketPool, cfg *config.RocketPoolConfig, printMessage func(string), blockNumber *big.Int) (*rocketpool.RocketPool, error) {
 
 	client := primary
@@ -79,33 +80,39 @@ func GetBestApiClient(primary *rocketpool.RocketPool, cfg *config.RocketPoolConf
 			strings.Contains(errMessage, "No state available for block") || // Nethermind
 			strings.Contains(errMessage, "Internal error") { // Besu
 
-			// The state was missing so fall back to the archive node
-			archiveEcUrl := cfg.Smartnode.ArchiveECUrl.Value.(string)
-			if archiveEcUrl != "" {
-				printMessage(fmt.Sprintf("Primary EC cannot retrieve state for historical block %d, using archive EC [%s]", blockNumber.Uint64(), archiveEcUrl))
-				ec, err := ethclient.Dial(archiveEcUrl)
-				if err != nil {
-					return nil, fmt.Errorf("Error connecting to archive EC: %w", err)
-				}
-				client, err = rocketpool.NewRocketPool(ec, common.HexToAddress(cfg.Smartnode.GetStorageAddress()))
+			// The state was missing so fall back to the archive nodes
+			archiveEcUrls := cfg.Smartnode.GetArchiveECUrls()
+			if len(archiveEcUrls) == 0 {
+				// No archive node specified
+				return nil, fmt.Errorf("***ERROR*** Primary EC cannot retrieve state for historical block %d and the Archive EC is not specified.", blockNumber.Uint64())
+			}
+
+			client = nil
+			for i, archiveEcUrl := range archiveEcUrls {
+				printMessage(fmt.Sprintf("Primary EC cannot retrieve state for historical block %d, trying archive EC %d of %d [%s]", blockNumber.Uint64(), i+1, len(archiveEcUrls), archiveEcUrl))
+				archiveClient, archiveAddress, err := getArchiveApiClient(cfg, archiveEcUrl, opts)
 				if err != nil {
-					return nil, fmt.Errorf("%s Error creating Rocket Pool client connected to archive EC: %w", err)
+					printMessage(err.Error())
+					continue
 				}
 
-				// Get the rETH address from the archive EC
-				address, err = client.RocketStorage.GetAddress(opts, crypto.Keccak256Hash([]byte("contract.addressrocketTokenRETH")))
-				if err != nil {
-					return nil, fmt.Errorf("%s Error verifying rETH address with Archive EC: %w", err)
+				// Sanity check the rETH address, moving on to the next archive EC if this one is wrong
+				if archiveAddress != cfg.Smartnode.GetRethAddress() {
+					printMessage(fmt.Sprintf("WARNING: Archive EC [%s] provided %s as the rETH address, but it should have been %s; trying the next one.", archiveEcUrl, archiveAddress.Hex(), cfg.Smartnode.GetRethAddress().Hex()))
+					continue
 				}
-			} else {
-				// No archive node specified
-				return nil, fmt.Errorf("***ERROR*** Primary EC cannot retrieve state for historical block %d and the Archive EC is not specified.", blockNumber.Uint64())
+				printMessage(fmt.Sprintf("Using archive EC [%s] for historical block %d", archiveEcUrl, blockNumber.Uint64()))
+				client = archiveClient
+				address = archiveAddress
+				break
+			}
+			if client == nil {
+				return nil, fmt.Errorf("***ERROR*** Primary EC cannot retrieve state for historical block %d and none of the %d Archive ECs could provide it either.", blockNumber.Uint64(), len(archiveEcUrls))
 			}
-
 		}
 	}
 
-	// Sanity check the rETH address to make sure the client is working right
+	// Sanity check the rETH address to make sure the client is working right; archive ECs were already checked above
 	if address != cfg.Smartnode.GetRethAddress() {
 		return nil, fmt.Errorf("***ERROR*** Your Primary EC provided %s as the rETH address, but it should have been %s!", address.Hex(), cfg.Smartnode.GetRethAddress().Hex())
 	}
@@ -113,3 +120,22 @@ func GetBestApiClient(primary *rocketpool.RocketPool, cfg *config.RocketPoolConf
 	return client, nil
 
 }
+
+// Connects to an Archive EC and verifies that it can serve the state for the requested block
+func getArchiveApiClient(cfg *config.RocketPoolConfig, archiveEcUrl string, opts *bind.CallOpts) (*rocketpool.RocketPool, common.Address, error) {
+	ec, err := ethclient.Dial(archiveEcUrl)
+	if err != nil {
+		return nil, common.Address{}, fmt.Errorf("Error connecting to archive EC [%s]: %w", archiveEcUrl, err)
+	}
+	client, err := rocketpool.NewRocketPool(ec, common.HexToAddress(cfg.Smartnode.GetStorageAddress()))
+	if err != nil {
+		return nil, common.Address{}, fmt.Errorf("Error creating Rocket Pool client connected to archive EC [%s]: %w", archiveEcUrl, err)
+	}
+
+	// Get the rETH address from the archive EC
+	address, err := client.RocketStorage.GetAddress(opts, crypto.Keccak256Hash([]byte("contract.addressrocketTokenRETH")))
+	if err != nil {
+		return nil, common.Address{}, fmt.Errorf("Error verifying rETH address with archive EC [%s]: %w", archiveEcUrl, err)
+	}
+	return client, address, nil
+}
@@ -1,16 +1,39 @@
 package log
 
 import (
+	"encoding/json"
+	"fmt"
 	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
 
 	"github.com/fatih/color"
 )
 
+// Matches a leading task prefix in a message, such as "[Merkle Tree] "
+var taskPrefixRegex = regexp.MustCompile(`^\[([^\]]+)\]\s*`)
+
 // Logger with ANSI color output
 type ColorLogger struct {
 	Color       color.Attribute
 	sprintFunc  func(a ...interface{}) string
 	sprintfFunc func(format string, a ...interface{}) string
+
+	// JSON output settings; if json is set, lines are written as JSON objects instead of colored text
+	json  bool
+	task  string
+	level string
+}
+
+// A single structured log line
+type jsonLogLine struct {
+	Timestamp string `json:"ts"`
+	Level     string `json:"level"`
+	Task      string `json:"task"`
+	Message   string `json:"msg"`
 }
 
 // Create new color logger
@@ -22,22 +45,119 @@ func NewColorLogger(colorAttr color.Attribute) ColorLogger {
 	}
 }
 
+// Create new logger that writes each line as a JSON object with the given task and level fields
+func NewJsonLogger(task string, level string) ColorLogger {
+	return ColorLogger{
+		sprintFunc:  fmt.Sprint,
+		sprintfFunc: fmt.Sprintf,
+		json:        true,
+		task:        task,
+		level:       level,
+	}
+}
+
 // Print values
 func (l *ColorLogger) Print(v ...interface{}) {
+	if l.json {
+		l.printJson(fmt.Sprint(v...))
+		return
+	}
 	log.Print(l.sprintFunc(v...))
 }
 
 // Print values with a newline
 func (l *ColorLogger) Println(v ...interface{}) {
+	if l.json {
+		l.printJson(fmt.Sprint(v...))
+		return
+	}
 	log.Println(l.sprintFunc(v...))
 }
 
 // Print a formatted string
 func (l *ColorLogger) Printf(format string, v ...interface{}) {
+	if l.json {
+		l.printJson(fmt.Sprintf(format, v...))
+		return
+	}
 	log.Print(l.sprintfFunc(format, v...))
 }
 
 // Print a formatted string with a newline
 func (l *ColorLogger) Printlnf(format string, v ...interface{}) {
+	if l.json {
+		l.printJson(fmt.Sprintf(format, v...))
+		return
+	}
 	log.Println(l.sprintfFunc(format, v...))
 }
+
+// Write a message as a JSON log line
+// A leading "[Task]" prefix in the message replaces the logger's task field, so callers that tag their messages inline still get structured output
+func (l *ColorLogger) printJson(message string) {
+	line := jsonLogLine{
+		Timestamp: time.Now().UTC().Format(time.RFC3339),
+		Level:     l.level,
+		Task:      l.task,
+		Message:   strings.TrimSpace(message),
+	}
+	if match := taskPrefixRegex.FindStringSubmatch(line.Message); match != nil {
+		line.Task = match[1]
+		line.Message = line.Message[len(match[0]):]
+	}
+
+	bytes, err := json.Marshal(line)
+	if err != nil {
+		log.Println(message)
+		return
+	}
+	fmt.Fprintln(log.Writer(), string(bytes))
+}
+
+// Logger that appends JSON lines to a file, for records that need to outlive the process that writes them
+type FileLogger struct {
+	path  string
+	task  string
+	level string
+}
+
+// Create a new logger that appends to the file at the given path
+func NewFileLogger(path string, task string, level string) FileLogger {
+	return FileLogger{
+		path:  path,
+		task:  task,
+		level: level,
+	}
+}
+
+// Append a formatted message to the log file
+func (l *FileLogger) Printlnf(format string, v ...interface{}) error {
+	line := jsonLogLine{
+		Timestamp: time.Now().UTC().Format(time.RFC3339),
+		Level:     l.level,
+		Task:      l.task,
+		Message:   strings.TrimSpace(fmt.Sprintf(format, v...)),
+	}
+	bytes, err := json.Marshal(line)
+	if err != nil {
+		return fmt.Errorf("error serializing log line: %w", err)
+	}
+
+	err = os.MkdirAll(filepath.Dir(l.path), 0700)
+	if err != nil {
+		return fmt.Errorf("error creating log folder for %s: %w", l.path, err)
+	}
+	file, err := os.OpenFile(l.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return fmt.Errorf("error opening log file %s: %w", l.path, err)
+	}
+	_, err = fmt.Fprintln(file, string(bytes))
+	closeErr := file.Close()
+	if err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("error writing to log file %s: %w", l.path, err)
+	}
+	return nil
+}
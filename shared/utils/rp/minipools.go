@@ -2,12 +2,16 @@ package rp
 
 import (
 	"bytes"
+	"fmt"
+	"math/big"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/rocketpool-go/dao/trustednode"
 	"github.com/rocket-pool/rocketpool-go/minipool"
 	"github.com/rocket-pool/rocketpool-go/rocketpool"
 	"github.com/rocket-pool/rocketpool-go/types"
+	"github.com/rocket-pool/rocketpool-go/utils/eth"
 	"golang.org/x/sync/errgroup"
 
 	"github.com/rocket-pool/smartnode/shared/services/beacon"
@@ -16,6 +20,9 @@ import (
 // Settings
 const MinipoolPubkeyBatchSize = 50
 
+// The number of penalties at which a minipool is considered to be cheating; it is excluded from Smoothing Pool rewards from then on
+const MinipoolPenaltyThreshold uint64 = 3
+
 // Get minipool validator statuses
 func GetMinipoolValidators(rp *rocketpool.RocketPool, bc beacon.Client, addresses []common.Address, callOpts *bind.CallOpts, validatorStatusOpts *beacon.ValidatorStatusOptions) (map[common.Address]beacon.ValidatorStatus, error) {
 
@@ -90,3 +97,33 @@ func GetMinipoolValidators(rp *rocketpool.RocketPool, bc beacon.Client, addresse
 	return validators, nil
 
 }
+
+// Get the number of Oracle DAO scrub votes that have been cast against a minipool
+func GetMinipoolTotalScrubVotes(mp *minipool.Minipool, opts *bind.CallOpts) (uint64, error) {
+	totalScrubVotes := new(*big.Int)
+	if err := mp.Contract.Call(opts, totalScrubVotes, "getTotalScrubVotes"); err != nil {
+		return 0, fmt.Errorf("Could not get minipool %s total scrub votes: %w", mp.Address.Hex(), err)
+	}
+	return (*totalScrubVotes).Uint64(), nil
+}
+
+// Get the number of Oracle DAO scrub votes required to scrub a minipool
+func GetScrubVoteThreshold(rp *rocketpool.RocketPool, opts *bind.CallOpts) (uint64, error) {
+	memberCount, err := trustednode.GetMemberCount(rp, opts)
+	if err != nil {
+		return 0, fmt.Errorf("Could not get Oracle DAO member count: %w", err)
+	}
+	settingsContract, err := rp.GetContract("rocketDAONodeTrustedSettingsMinipool")
+	if err != nil {
+		return 0, err
+	}
+	scrubQuorum := new(*big.Int)
+	if err := settingsContract.Call(opts, scrubQuorum, "getScrubQuorum"); err != nil {
+		return 0, fmt.Errorf("Could not get scrub quorum: %w", err)
+	}
+
+	// A minipool is scrubbed once its votes exceed the quorum fraction of the Oracle DAO
+	threshold := new(big.Int).Mul(new(big.Int).SetUint64(memberCount), *scrubQuorum)
+	threshold.Div(threshold, eth.EthToWei(1))
+	return threshold.Uint64() + 1, nil
+}
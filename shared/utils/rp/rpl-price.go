@@ -0,0 +1,44 @@
+package rp
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	"github.com/rocket-pool/smartnode/shared/services/contracts"
+)
+
+// Get the RPL price in ETH from the 1inch oracle, which is the source the Oracle DAO uses for its price submissions
+func GetOracleRplPrice(cfg *config.RocketPoolConfig, client bind.ContractBackend, opts *bind.CallOpts) (*big.Int, error) {
+
+	// Make sure the oracle is deployed
+	oracleAddress := common.HexToAddress(cfg.Smartnode.GetOneInchOracleAddress())
+	var blockNumber *big.Int
+	if opts != nil {
+		blockNumber = opts.BlockNumber
+	}
+	code, err := client.CodeAt(context.Background(), oracleAddress, blockNumber)
+	if err != nil {
+		return nil, fmt.Errorf("Could not check for the 1inch oracle contract at %s: %w", oracleAddress.Hex(), err)
+	}
+	if len(code) == 0 {
+		return nil, fmt.Errorf("The 1inch oracle contract is not deployed at %s on this network", oracleAddress.Hex())
+	}
+
+	// Get the RPL price
+	oio, err := contracts.NewOneInchOracle(oracleAddress, client)
+	if err != nil {
+		return nil, err
+	}
+	rplAddress := common.HexToAddress(cfg.Smartnode.GetRplTokenAddress())
+	rplPrice, err := oio.GetRateToEth(opts, rplAddress, true)
+	if err != nil {
+		return nil, fmt.Errorf("Could not get RPL price from the 1inch oracle: %w", err)
+	}
+	return rplPrice, nil
+
+}
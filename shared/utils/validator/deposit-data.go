@@ -1,16 +1,57 @@
 package validator
 
 import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"strings"
+
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/prysmaticlabs/prysm/v2/beacon-chain/core/signing"
+	prdeposit "github.com/prysmaticlabs/prysm/v2/contracts/deposit"
+	ethpb "github.com/prysmaticlabs/prysm/v2/proto/prysm/v1alpha1"
+	rptypes "github.com/rocket-pool/rocketpool-go/types"
 	"github.com/rocket-pool/smartnode/shared/types/eth2"
 	eth2types "github.com/wealdtech/go-eth2-types/v2"
 
 	"github.com/rocket-pool/smartnode/shared/services/beacon"
+	"github.com/rocket-pool/smartnode/shared/services/config"
 )
 
 // Deposit settings
 const DepositAmount = 16000000000 // gwei
 
+// Get the eth2 config to use for creating and validating deposits, applying the genesis fork version override if one is set.
+// The override is only honored on the Custom network; it's ignored everywhere else (and rejected by the config validation).
+func GetDepositEth2Config(cfg *config.RocketPoolConfig, bc beacon.Client) (beacon.Eth2Config, error) {
+
+	// Get the eth2 config from the Beacon client
+	eth2Config, err := bc.GetEth2Config()
+	if err != nil {
+		return beacon.Eth2Config{}, err
+	}
+
+	// Apply the override
+	override := cfg.Smartnode.GetGenesisForkVersionOverride()
+	if override == "" {
+		return eth2Config, nil
+	}
+	forkVersion, err := hex.DecodeString(strings.TrimPrefix(override, "0x"))
+	if err != nil {
+		return beacon.Eth2Config{}, fmt.Errorf("Invalid genesis fork version override [%s]: %w", override, err)
+	}
+	if len(forkVersion) != 4 {
+		return beacon.Eth2Config{}, fmt.Errorf("Invalid genesis fork version override [%s]: expected 4 bytes but got %d", override, len(forkVersion))
+	}
+	if !bytes.Equal(forkVersion, eth2Config.GenesisForkVersion) {
+		log.Printf("WARNING: Using the genesis fork version override 0x%s instead of the Beacon client's genesis fork version 0x%s for validator deposits. Deposits signed with the wrong fork version are invalid and the ETH sent with them will be lost.\n", hex.EncodeToString(forkVersion), hex.EncodeToString(eth2Config.GenesisForkVersion))
+	}
+	eth2Config.GenesisForkVersion = forkVersion
+	return eth2Config, nil
+
+}
+
 // Get deposit data & root for a given validator key and withdrawal credentials
 func GetDepositData(validatorKey *eth2types.BLSPrivateKey, withdrawalCredentials common.Hash, eth2Config beacon.Eth2Config) (eth2.DepositData, common.Hash, error) {
 
@@ -56,3 +97,40 @@ func GetDepositData(validatorKey *eth2types.BLSPrivateKey, withdrawalCredentials
 	return depositData, depositDataRoot, nil
 
 }
+
+// Verify that a deposit's signature is valid for the deposit domain of the provided eth2 config
+func ValidateDepositInfo(eth2Config beacon.Eth2Config, depositAmount uint64, pubkey rptypes.ValidatorPubkey, withdrawalCredentials common.Hash, signature rptypes.ValidatorSignature) error {
+
+	// Get the deposit domain based on the eth2 config
+	depositDomain, err := signing.ComputeDomain(eth2types.DomainDeposit, eth2Config.GenesisForkVersion, eth2types.ZeroGenesisValidatorsRoot)
+	if err != nil {
+		return err
+	}
+
+	// Create the deposit struct
+	depositData := new(ethpb.Deposit_Data)
+	depositData.Amount = depositAmount
+	depositData.PublicKey = pubkey.Bytes()
+	depositData.WithdrawalCredentials = withdrawalCredentials.Bytes()
+	depositData.Signature = signature.Bytes()
+
+	// Validate the signature
+	err = prdeposit.VerifyDepositSignature(depositData, depositDomain)
+	return err
+
+}
+
+// Get the deposit data root of a signed deposit
+func GetDepositDataRoot(depositAmount uint64, pubkey rptypes.ValidatorPubkey, withdrawalCredentials common.Hash, signature rptypes.ValidatorSignature) (common.Hash, error) {
+	depositData := eth2.DepositData{
+		PublicKey:             pubkey.Bytes(),
+		WithdrawalCredentials: withdrawalCredentials.Bytes(),
+		Amount:                depositAmount,
+		Signature:             signature.Bytes(),
+	}
+	depositDataRoot, err := depositData.HashTreeRoot()
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return depositDataRoot, nil
+}
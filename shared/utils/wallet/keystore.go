@@ -0,0 +1,53 @@
+package wallet
+
+import (
+	"fmt"
+
+	"github.com/rocket-pool/rocketpool-go/types"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+	eth2types "github.com/wealdtech/go-eth2-types/v2"
+	eth2ks "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+)
+
+// Decrypt an EIP-2335 validator keystore and verify that the private key matches the pubkey it claims to be for
+// NOTE: the BLS library must be initialized before calling this
+func DecryptValidatorKeystore(keystore api.ValidatorKeystore, password string) (*eth2types.BLSPrivateKey, error) {
+
+	// Get the encryption function it uses
+	kdf, exists := keystore.Crypto["kdf"]
+	if !exists {
+		return nil, fmt.Errorf("\"crypto\" didn't contain a subkey named \"kdf\"")
+	}
+	kdfMap, ok := kdf.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("\"crypto.kdf\" was not an object")
+	}
+	function, exists := kdfMap["function"]
+	if !exists {
+		return nil, fmt.Errorf("\"crypto.kdf\" didn't contain a subkey named \"function\"")
+	}
+	functionString, ok := function.(string)
+	if !ok {
+		return nil, fmt.Errorf("\"crypto.kdf.function\" was not a string")
+	}
+
+	// Decrypt the private key
+	encryptor := eth2ks.New(eth2ks.WithCipher(functionString))
+	decryptedKey, err := encryptor.Decrypt(keystore.Crypto, password)
+	if err != nil {
+		return nil, fmt.Errorf("error decrypting keystore for validator %s: %w", keystore.Pubkey.Hex(), err)
+	}
+	privateKey, err := eth2types.BLSPrivateKeyFromBytes(decryptedKey)
+	if err != nil {
+		return nil, fmt.Errorf("error recreating private key for validator %s: %w", keystore.Pubkey.Hex(), err)
+	}
+
+	// Verify the private key matches the public key
+	reconstructedPubkey := types.BytesToValidatorPubkey(privateKey.PublicKey().Marshal())
+	if reconstructedPubkey != keystore.Pubkey {
+		return nil, fmt.Errorf("keystore claims to be for validator %s but it's for validator %s", keystore.Pubkey.Hex(), reconstructedPubkey.Hex())
+	}
+
+	return privateKey, nil
+
+}